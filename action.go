@@ -16,12 +16,13 @@ const (
 	// ActionResultTagKind is used to identify the Tag type
 	ActionResultTagKind = "actionresult"
 
-	// actionMarker is the identifier used to join filterable
-	// prefixes for Action Id's with unique suffixes
-	actionMarker = "_a_"
+	// ActionMarker is the identifier used to join filterable
+	// prefixes for Action Id's with unique suffixes.
+	ActionMarker = "_a_"
 
-	// actionResultMarker is the token used to delimit a filterable prefix from unique suffix
-	actionResultMarker = "_ar_"
+	// ActionResultMarker is the token used to delimit a filterable
+	// prefix from unique suffix in ActionResult Id's.
+	ActionResultMarker = "_ar_"
 )
 
 //
@@ -47,7 +48,7 @@ func NewActionTag(id string) ActionTag {
 
 // JoinActionTag reconstitutes an ActionTag from it's prefix and sequence
 func JoinActionTag(prefix string, sequence int) ActionTag {
-	actionId := fmt.Sprintf("%s%s%d", prefix, actionMarker, sequence)
+	actionId := joinId(prefix, ActionMarker, sequence)
 	tag, ok := newActionTag(actionId)
 	if !ok {
 		panic("bad prefix or sequence")
@@ -55,12 +56,33 @@ func JoinActionTag(prefix string, sequence int) ActionTag {
 	return tag
 }
 
+// NewActionTags returns the tags of count consecutive actions for the
+// unit or service named by prefix, starting at sequence start. The
+// prefix is validated once for the whole batch.
+func NewActionTags(prefix string, start, count int) ([]ActionTag, error) {
+	if !isValidIdPrefix(prefix) {
+		return nil, fmt.Errorf("%q is not a valid action prefix", prefix)
+	}
+	if start < 0 || count < 0 || start+count < start {
+		return nil, fmt.Errorf("invalid action sequence range: start %d, count %d", start, count)
+	}
+	tags := make([]ActionTag, count)
+	for i := range tags {
+		tags[i] = ActionTag{IdPrefixer: IdPrefixer{
+			Id_:     joinId(prefix, ActionMarker, start+i),
+			Kind_:   ActionTagKind,
+			Marker_: ActionMarker,
+		}}
+	}
+	return tags, nil
+}
+
 // IsValidAction returns whether actionId is a valid actionId
-// Valid action ids include the names.actionMarker token that delimits
+// Valid action ids include the names.ActionMarker token that delimits
 // a prefix that can be used for filtering, and a suffix that should be
 // unique.  The prefix should match the name rules for units
 func IsValidAction(actionId string) bool {
-	return isValidIdPrefixTag(actionId, actionMarker)
+	return isValidIdPrefixTag(actionId, ActionMarker)
 }
 
 // ParseActionTag parses a action tag string.
@@ -76,14 +98,32 @@ func ParseActionTag(actionTag string) (ActionTag, error) {
 	return st, nil
 }
 
+// WithSequence returns the tag of the action with the same prefix as
+// t and the given sequence. It panics if sequence is negative.
+func (t ActionTag) WithSequence(sequence int) ActionTag {
+	return ActionTag{IdPrefixer: t.withSequence(sequence)}
+}
+
+// NextSequence returns the tag of the action that follows t for the
+// same prefix.
+func (t ActionTag) NextSequence() ActionTag {
+	return t.WithSequence(t.Sequence() + 1)
+}
+
+// PrevSequence returns the tag of the action that precedes t for the
+// same prefix. It panics if t has sequence 0.
+func (t ActionTag) PrevSequence() ActionTag {
+	return t.WithSequence(t.Sequence() - 1)
+}
+
 func newActionTag(actionId string) (ActionTag, bool) {
-	if !isValidIdPrefixTag(actionId, actionMarker) {
+	if !isValidIdPrefixTag(actionId, ActionMarker) {
 		return ActionTag{}, false
 	}
 	prefixer := IdPrefixer{
 		Id_:     actionId,
 		Kind_:   ActionTagKind,
-		Marker_: actionMarker,
+		Marker_: ActionMarker,
 	}
 	return ActionTag{IdPrefixer: prefixer}, true
 }
@@ -109,11 +149,11 @@ func NewActionResultTag(id string) ActionResultTag {
 }
 
 // IsValidActionResult returns whether resultId is a valid actionResultId
-// Valid action result ids include the names.actionResultMarker token that delimits
+// Valid action result ids include the names.ActionResultMarker token that delimits
 // a prefix that can be used for filtering, and a suffix that should be
 // unique. The prefix should match the name rules for units or services
 func IsValidActionResult(resultId string) bool {
-	return isValidIdPrefixTag(resultId, actionResultMarker)
+	return isValidIdPrefixTag(resultId, ActionResultMarker)
 }
 
 // ParseActionResultTag parses a action result tag string.
@@ -129,14 +169,33 @@ func ParseActionResultTag(actionResultTag string) (ActionResultTag, error) {
 	return st, nil
 }
 
+// WithSequence returns the tag of the action result with the same
+// prefix as t and the given sequence. It panics if sequence is
+// negative.
+func (t ActionResultTag) WithSequence(sequence int) ActionResultTag {
+	return ActionResultTag{IdPrefixer: t.withSequence(sequence)}
+}
+
+// NextSequence returns the tag of the action result that follows t for
+// the same prefix.
+func (t ActionResultTag) NextSequence() ActionResultTag {
+	return t.WithSequence(t.Sequence() + 1)
+}
+
+// PrevSequence returns the tag of the action result that precedes t
+// for the same prefix. It panics if t has sequence 0.
+func (t ActionResultTag) PrevSequence() ActionResultTag {
+	return t.WithSequence(t.Sequence() - 1)
+}
+
 func newActionResultTag(resultId string) (ActionResultTag, bool) {
-	if !isValidIdPrefixTag(resultId, actionResultMarker) {
+	if !isValidIdPrefixTag(resultId, ActionResultMarker) {
 		return ActionResultTag{}, false
 	}
 	prefixer := IdPrefixer{
 		Id_:     resultId,
 		Kind_:   ActionResultTagKind,
-		Marker_: actionResultMarker,
+		Marker_: ActionResultMarker,
 	}
 	return ActionResultTag{IdPrefixer: prefixer}, true
 }
@@ -173,6 +232,12 @@ func (t IdPrefixer) Id() string { return t.Id_ }
 // String returns a string that shows the type and id of the Tag
 func (t IdPrefixer) String() string { return t.Kind_ + "-" + t.Id() }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t IdPrefixer) AppendString(dst []byte) []byte {
+	return append(append(append(dst, t.Kind_...), KindSeparator...), t.Id_...)
+}
+
 // Kind exposes the value to identify what kind of Tag this is
 func (t IdPrefixer) Kind() string { return t.Kind_ }
 
@@ -211,7 +276,7 @@ func (t IdPrefixer) PrefixTag() Tag {
 	case IsValidService(prefix):
 		tag = NewServiceTag(prefix)
 	default:
-		tag, err = ParseTag(prefix)
+		tag, err = parseTag(prefix)
 		if err != nil {
 			tag = nil
 		}
@@ -219,6 +284,16 @@ func (t IdPrefixer) PrefixTag() Tag {
 	return tag
 }
 
+// withSequence returns a copy of t with its sequence replaced.
+func (t IdPrefixer) withSequence(sequence int) IdPrefixer {
+	prefix, _, ok := splitId(t.Id(), t.Marker_)
+	if !ok || sequence < 0 {
+		panic(fmt.Sprintf("cannot set sequence %d on %q", sequence, t.String()))
+	}
+	t.Id_ = joinId(prefix, t.Marker_, sequence)
+	return t
+}
+
 // isValidIdPrefixTag signals whether the id is a validly formatted id
 // for an IdPrefixer with the given marker
 func isValidIdPrefixTag(id, marker string) bool {
@@ -226,13 +301,19 @@ func isValidIdPrefixTag(id, marker string) bool {
 	if !ok {
 		return false
 	}
-	switch {
-	case IsValidUnit(prefix):
-	case IsValidService(prefix):
-	default:
-		return false
-	}
-	return true
+	return isValidIdPrefix(prefix)
+}
+
+// isValidIdPrefix signals whether prefix names an entity that can
+// prefix the id of an IdPrefixer.
+func isValidIdPrefix(prefix string) bool {
+	return IsValidUnit(prefix) || IsValidService(prefix)
+}
+
+// joinId builds the id of an IdPrefixer from its prefix, marker and
+// sequence.
+func joinId(prefix, marker string, sequence int) string {
+	return prefix + marker + strconv.Itoa(sequence)
 }
 
 // splitId extracts the prefix and suffix from the id using the marker
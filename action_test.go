@@ -243,3 +243,43 @@ func (s *actionSuite) TestPrefixSuffix(c *gc.C) {
 		c.Assert(action.PrefixTag(), gc.DeepEquals, result.PrefixTag())
 	}
 }
+
+func (s *actionSuite) TestNewActionTags(c *gc.C) {
+	tags, err := names.NewActionTags("wordpress/0", 5, 3)
+	c.Assert(err, gc.IsNil)
+	c.Assert(tags, gc.HasLen, 3)
+	for i, tag := range tags {
+		c.Check(tag, gc.Equals, names.JoinActionTag("wordpress/0", 5+i))
+		c.Check(tag.Prefix(), gc.Equals, "wordpress/0")
+		c.Check(tag.Sequence(), gc.Equals, 5+i)
+	}
+
+	tags, err = names.NewActionTags("wordpress", 0, 0)
+	c.Assert(err, gc.IsNil)
+	c.Assert(tags, gc.HasLen, 0)
+
+	_, err = names.NewActionTags("wordpress/00", 0, 1)
+	c.Check(err, gc.ErrorMatches, `"wordpress/00" is not a valid action prefix`)
+	_, err = names.NewActionTags("wordpress/0", -1, 1)
+	c.Check(err, gc.ErrorMatches, `invalid action sequence range: start -1, count 1`)
+	_, err = names.NewActionTags("wordpress/0", 0, -1)
+	c.Check(err, gc.ErrorMatches, `invalid action sequence range: start 0, count -1`)
+}
+
+func (s *actionSuite) TestSequenceHelpers(c *gc.C) {
+	action := names.JoinActionTag("wordpress/0", 7)
+	c.Check(action.WithSequence(3), gc.Equals, names.JoinActionTag("wordpress/0", 3))
+	c.Check(action.NextSequence(), gc.Equals, names.JoinActionTag("wordpress/0", 8))
+	c.Check(action.PrevSequence(), gc.Equals, names.JoinActionTag("wordpress/0", 6))
+	c.Check(action.WithSequence(0).PrevSequence, gc.PanicMatches,
+		`cannot set sequence -1 on "action-wordpress/0`+names.ActionMarker+`0"`)
+
+	result := names.NewActionResultTag("wordpress" + names.ActionResultMarker + "9")
+	next := result.NextSequence()
+	c.Check(next.Id(), gc.Equals, "wordpress"+names.ActionResultMarker+"10")
+	c.Check(next.Kind(), gc.Equals, names.ActionResultTagKind)
+	c.Check(next.PrevSequence(), gc.Equals, result)
+	c.Check(result.WithSequence(1).Sequence(), gc.Equals, 1)
+	c.Check(func() { result.WithSequence(-2) }, gc.PanicMatches,
+		`cannot set sequence -2 on "actionresult-wordpress`+names.ActionResultMarker+`9"`)
+}
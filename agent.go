@@ -0,0 +1,113 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+// AgentTag is the tag of an entity that runs an agent: a machine, a
+// unit or a controller agent. It wraps the tag of the entity, with which it shares its
+// kind, id and string representation.
+type AgentTag struct {
+	entity Tag
+}
+
+var _ Tag = AgentTag{}
+
+// NewAgentTag returns an AgentTag for the entity with the given tag,
+// or an error if entity is nil or that kind of entity does not run an
+// agent.
+func NewAgentTag(entity Tag) (AgentTag, error) {
+	if entity == nil {
+		return AgentTag{}, fmt.Errorf("nil tag is not an agent tag")
+	}
+	if !isAgentKind(entity.Kind()) {
+		return AgentTag{}, fmt.Errorf("%q is not an agent tag", entity.String())
+	}
+	return AgentTag{entity: entity}, nil
+}
+
+// ParseAgentTag parses the tag of an entity that runs an agent.
+func ParseAgentTag(agentTag string) (AgentTag, error) {
+	tag, err := ParseTag(agentTag)
+	if err != nil {
+		return AgentTag{}, err
+	}
+	if !isAgentKind(tag.Kind()) {
+		return AgentTag{}, invalidTagError(agentTag, "agent")
+	}
+	return AgentTag{entity: tag}, nil
+}
+
+// Entity returns the tag of the entity that runs the agent, such as
+// a MachineTag or a UnitTag.
+func (t AgentTag) Entity() Tag { return t.entity }
+
+// String returns the string representation of the entity's tag. Like
+// Kind and Id, it returns the empty string for the zero AgentTag, which
+// wraps no entity.
+func (t AgentTag) String() string {
+	if t.entity == nil {
+		return ""
+	}
+	return t.entity.String()
+}
+
+func (t AgentTag) Kind() string {
+	if t.entity == nil {
+		return ""
+	}
+	return t.entity.Kind()
+}
+
+func (t AgentTag) Id() string {
+	if t.entity == nil {
+		return ""
+	}
+	return t.entity.Id()
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t AgentTag) AppendString(dst []byte) []byte {
+	if t.entity == nil {
+		return dst
+	}
+	if a, ok := t.entity.(stringAppender); ok {
+		return a.AppendString(dst)
+	}
+	return append(dst, t.entity.String()...)
+}
+
+// IsAgent returns whether t is the tag of an entity that runs an
+// agent.
+func IsAgent(t Tag) bool {
+	return isAgentKind(t.Kind())
+}
+
+// IsMachineAgent returns whether t is the tag of a machine agent.
+func IsMachineAgent(t Tag) bool {
+	return t.Kind() == MachineTagKind
+}
+
+// IsUnitAgent returns whether t is the tag of a unit agent.
+func IsUnitAgent(t Tag) bool {
+	return t.Kind() == UnitTagKind
+}
+
+// IsControllerAgent returns whether t is the tag of a controller
+// agent.
+func IsControllerAgent(t Tag) bool {
+	return t.Kind() == ControllerAgentTagKind
+}
+
+// isAgentKind returns whether entities of the given kind run agents.
+func isAgentKind(kind string) bool {
+	switch kind {
+	case MachineTagKind, UnitTagKind, ControllerAgentTagKind:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type agentSuite struct{}
+
+var _ = gc.Suite(&agentSuite{})
+
+var parseAgentTagTests = []struct {
+	tag    string
+	entity names.Tag
+	err    string
+}{{
+	tag:    "machine-0-lxc-1",
+	entity: names.NewMachineTag("0/lxc/1"),
+}, {
+	tag:    "unit-mysql-0",
+	entity: names.NewUnitTag("mysql/0"),
+}, {
+	tag: "service-mysql",
+	err: `"service-mysql" is not a valid agent tag`,
+}, {
+	tag: "user-bob",
+	err: `"user-bob" is not a valid agent tag`,
+}, {
+	tag: "unit-mysql",
+	err: `"unit-mysql" is not a valid unit tag`,
+}, {
+	tag: "foo",
+	err: `"foo" is not a valid tag`,
+}}
+
+func (s *agentSuite) TestParseAgentTag(c *gc.C) {
+	for i, test := range parseAgentTagTests {
+		c.Logf("test %d: %s", i, test.tag)
+		tag, err := names.ParseAgentTag(test.tag)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+		c.Check(tag.Entity(), gc.Equals, test.entity)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Kind(), gc.Equals, test.entity.Kind())
+		c.Check(tag.Id(), gc.Equals, test.entity.Id())
+	}
+}
+
+func (s *agentSuite) TestNewAgentTag(c *gc.C) {
+	tag, err := names.NewAgentTag(names.NewUnitTag("mysql/0"))
+	c.Assert(err, gc.IsNil)
+	c.Check(tag.Entity(), gc.Equals, names.NewUnitTag("mysql/0"))
+
+	_, err = names.NewAgentTag(names.NewServiceTag("mysql"))
+	c.Check(err, gc.ErrorMatches, `"service-mysql" is not an agent tag`)
+
+	_, err = names.NewAgentTag(nil)
+	c.Check(err, gc.ErrorMatches, `nil tag is not an agent tag`)
+}
+
+func (s *agentSuite) TestAgentPredicates(c *gc.C) {
+	machineAgent, err := names.ParseAgentTag("machine-1")
+	c.Assert(err, gc.IsNil)
+	for i, test := range []struct {
+		tag     names.Tag
+		agent   bool
+		machine bool
+		unit    bool
+	}{
+		{names.NewMachineTag("0"), true, true, false},
+		{names.NewMachineTag("0/lxc/1"), true, true, false},
+		{machineAgent, true, true, false},
+		{names.NewUnitTag("mysql/0"), true, false, true},
+		{names.NewServiceTag("mysql"), false, false, false},
+		{names.NewUserTag("bob"), false, false, false},
+		{names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), false, false, false},
+		{names.NewControllerAgentTag("0"), true, false, false},
+	} {
+		c.Logf("test %d: %s", i, test.tag)
+		c.Check(names.IsAgent(test.tag), gc.Equals, test.agent)
+		c.Check(names.IsMachineAgent(test.tag), gc.Equals, test.machine)
+		c.Check(names.IsUnitAgent(test.tag), gc.Equals, test.unit)
+	}
+}
+
+func (s *agentSuite) TestZeroAgentTag(c *gc.C) {
+	var t names.AgentTag
+	c.Check(t.String(), gc.Equals, "")
+	c.Check(t.Kind(), gc.Equals, "")
+	c.Check(t.Id(), gc.Equals, "")
+	c.Check(t.Entity(), gc.IsNil)
+	c.Check(string(t.AppendString([]byte("x"))), gc.Equals, "x")
+	c.Check(fmt.Sprint(t), gc.Equals, "")
+	c.Check(names.IsAgent(t), gc.Equals, false)
+}
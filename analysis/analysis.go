@@ -0,0 +1,170 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package analysis provides a go/analysis Analyzer that reports
+// constant strings passed to the tag constructors and parsers of
+// github.com/juju/names that can never be valid, such as
+// names.NewUnitTag("mysql"), catching at build time calls that would
+// panic or fail at run time.
+package analysis
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"net"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/juju/names"
+)
+
+const namesPath = "github.com/juju/names"
+
+// Analyzer reports invalid constant arguments to names functions.
+var Analyzer = &analysis.Analyzer{
+	Name:     "nameliterals",
+	Doc:      "report constant strings passed to github.com/juju/names constructors and parsers that can never be valid",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// idCheckers maps the names functions whose first argument is an id
+// to the function that validates it, and a description of the id.
+var idCheckers = map[string]struct {
+	valid func(string) bool
+	what  string
+}{
+	"NewUnitTag":              {names.IsValidUnit, "unit name"},
+	"UnitService":             {names.IsValidUnit, "unit name"},
+	"NewMachineTag":           {names.IsValidMachine, "machine id"},
+	"NewServiceTag":           {names.IsValidService, "service name"},
+	"NewEnvironTag":           {names.IsValidEnvironment, "environment UUID"},
+	"NewUserTag":              {names.IsValidUser, "user id"},
+	"NewLocalUserTag":         {names.IsValidUserName, "user name"},
+	"NewRelationTag":          {names.IsValidRelation, "relation key"},
+	"NewNetworkTag":           {names.IsValidNetwork, "network name"},
+	"NewActionTag":            {names.IsValidAction, "action id"},
+	"NewActionResultTag":      {names.IsValidActionResult, "action result id"},
+	"NewVolumeTag":            {names.IsValidVolume, "volume id"},
+	"NewFilesystemTag":        {names.IsValidFilesystem, "filesystem id"},
+	"NewSpaceTag":             {names.IsValidSpace, "space name"},
+	"NewSubnetTag":            {isCIDR, "subnet CIDR"},
+	"NewIPAddressTag":         {names.IsValidIPAddress, "IP address"},
+	"NewModelTag":             {names.IsValidModel, "model UUID"},
+	"NewControllerTag":        {names.IsValidController, "controller UUID"},
+	"NewControllerAgentTag":   {names.IsValidControllerAgent, "controller agent id"},
+	"NewCloudTag":             {names.IsValidCloud, "cloud name"},
+	"NewCloudCredentialTag":   {names.IsValidCloudCredential, "cloud credential id"},
+	"NewApplicationTag":       {names.IsValidApplication, "application name"},
+	"NewApplicationOfferTag":  {names.IsValidApplicationOffer, "application offer id"},
+	"NewRemoteApplicationTag": {names.IsValidRemoteApplication, "remote application name"},
+	"NewRemoteRelationTag":    {names.IsValidRemoteRelation, "remote relation key"},
+	"NewOperationTag":         {names.IsValidOperation, "operation id"},
+	"NewSecretTag":            {names.IsValidSecret, "secret id"},
+	"NewSecretBackendTag":     {names.IsValidSecretBackend, "secret backend id"},
+	"NewCharmTag":             {names.IsValidCharmURL, "charm URL"},
+	"NewResourceTag":          {names.IsValidResource, "resource id"},
+	"NewMetricBatchTag":       {names.IsValidMetricBatch, "metric batch UUID"},
+	"NewInstanceTag":          {names.IsValidInstance, "instance id"},
+	"NewAvailabilityZoneTag":  {names.IsValidAvailabilityZone, "availability zone name"},
+	"NewCloudRegionTag":       {names.IsValidCloudRegion, "cloud region id"},
+	"NewImageTag":             {names.IsValidImage, "image id"},
+	"NewSSHKeyTag":            {names.IsValidSSHKey, "SSH key fingerprint"},
+	"NewCertificateTag":       {names.IsValidCertificate, "certificate id"},
+	"NewBackupTag":            {names.IsValidBackup, "backup id"},
+	"NewUpgradeTag":           {names.IsValidUpgrade, "upgrade UUID"},
+	"NewMigrationTag":         {names.IsValidMigration, "migration UUID"},
+	"NewLeaseTag":             {names.IsValidLease, "lease id"},
+	"NewAuditEntryTag":        {names.IsValidAuditEntry, "audit entry id"},
+	"NewTokenTag":             {names.IsValidToken, "token id"},
+	"NewStorageTag":           {names.IsValidStorage, "storage instance id"},
+}
+
+// isCIDR returns whether s is a subnet in CIDR notation, as accepted
+// by NewSubnetTag, which canonicalizes it; IsValidSubnet accepts only
+// the canonical form.
+func isCIDR(s string) bool {
+	_, _, err := net.ParseCIDR(s)
+	return err == nil
+}
+
+// tagParsers maps the names functions whose first argument is a tag
+// string to the function that parses it.
+var tagParsers = map[string]func(string) error{
+	"ParseTag":                     func(s string) error { _, err := names.ParseTag(s); return err },
+	"ParseUnitTag":                 func(s string) error { _, err := names.ParseUnitTag(s); return err },
+	"ParseMachineTag":              func(s string) error { _, err := names.ParseMachineTag(s); return err },
+	"ParseServiceTag":              func(s string) error { _, err := names.ParseServiceTag(s); return err },
+	"ParseEnvironTag":              func(s string) error { _, err := names.ParseEnvironTag(s); return err },
+	"ParseUserTag":                 func(s string) error { _, err := names.ParseUserTag(s); return err },
+	"ParseRelationTag":             func(s string) error { _, err := names.ParseRelationTag(s); return err },
+	"ParseNetworkTag":              func(s string) error { _, err := names.ParseNetworkTag(s); return err },
+	"ParseActionTag":               func(s string) error { _, err := names.ParseActionTag(s); return err },
+	"ParseActionResultTag":         func(s string) error { _, err := names.ParseActionResultTag(s); return err },
+	"ParseVolumeTag":               func(s string) error { _, err := names.ParseVolumeTag(s); return err },
+	"ParseFilesystemTag":           func(s string) error { _, err := names.ParseFilesystemTag(s); return err },
+	"ParseStorageTag":              func(s string) error { _, err := names.ParseStorageTag(s); return err },
+	"ParseStorageAttachmentTag":    func(s string) error { _, err := names.ParseStorageAttachmentTag(s); return err },
+	"ParseVolumeAttachmentTag":     func(s string) error { _, err := names.ParseVolumeAttachmentTag(s); return err },
+	"ParseFilesystemAttachmentTag": func(s string) error { _, err := names.ParseFilesystemAttachmentTag(s); return err },
+	"ParseSpaceTag":                func(s string) error { _, err := names.ParseSpaceTag(s); return err },
+	"ParseSubnetTag":               func(s string) error { _, err := names.ParseSubnetTag(s); return err },
+	"ParseIPAddressTag":            func(s string) error { _, err := names.ParseIPAddressTag(s); return err },
+	"ParseModelTag":                func(s string) error { _, err := names.ParseModelTag(s); return err },
+	"ParseControllerTag":           func(s string) error { _, err := names.ParseControllerTag(s); return err },
+	"ParseControllerAgentTag":      func(s string) error { _, err := names.ParseControllerAgentTag(s); return err },
+	"ParseCloudTag":                func(s string) error { _, err := names.ParseCloudTag(s); return err },
+	"ParseCloudCredentialTag":      func(s string) error { _, err := names.ParseCloudCredentialTag(s); return err },
+	"ParseApplicationTag":          func(s string) error { _, err := names.ParseApplicationTag(s); return err },
+	"ParseApplicationOfferTag":     func(s string) error { _, err := names.ParseApplicationOfferTag(s); return err },
+	"ParseRemoteApplicationTag":    func(s string) error { _, err := names.ParseRemoteApplicationTag(s); return err },
+	"ParseRemoteRelationTag":       func(s string) error { _, err := names.ParseRemoteRelationTag(s); return err },
+	"ParseOperationTag":            func(s string) error { _, err := names.ParseOperationTag(s); return err },
+	"ParseSecretTag":               func(s string) error { _, err := names.ParseSecretTag(s); return err },
+	"ParseSecretBackendTag":        func(s string) error { _, err := names.ParseSecretBackendTag(s); return err },
+	"ParseCharmTag":                func(s string) error { _, err := names.ParseCharmTag(s); return err },
+	"ParseResourceTag":             func(s string) error { _, err := names.ParseResourceTag(s); return err },
+	"ParseMetricBatchTag":          func(s string) error { _, err := names.ParseMetricBatchTag(s); return err },
+	"ParseInstanceTag":             func(s string) error { _, err := names.ParseInstanceTag(s); return err },
+	"ParseAvailabilityZoneTag":     func(s string) error { _, err := names.ParseAvailabilityZoneTag(s); return err },
+	"ParseCloudRegionTag":          func(s string) error { _, err := names.ParseCloudRegionTag(s); return err },
+	"ParseImageTag":                func(s string) error { _, err := names.ParseImageTag(s); return err },
+	"ParseSSHKeyTag":               func(s string) error { _, err := names.ParseSSHKeyTag(s); return err },
+	"ParseCertificateTag":          func(s string) error { _, err := names.ParseCertificateTag(s); return err },
+	"ParseBackupTag":               func(s string) error { _, err := names.ParseBackupTag(s); return err },
+	"ParseUpgradeTag":              func(s string) error { _, err := names.ParseUpgradeTag(s); return err },
+	"ParseMigrationTag":            func(s string) error { _, err := names.ParseMigrationTag(s); return err },
+	"ParseLeaseTag":                func(s string) error { _, err := names.ParseLeaseTag(s); return err },
+	"ParseAuditEntryTag":           func(s string) error { _, err := names.ParseAuditEntryTag(s); return err },
+	"ParseTokenTag":                func(s string) error { _, err := names.ParseTokenTag(s); return err },
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != namesPath || len(call.Args) == 0 {
+			return
+		}
+		arg := call.Args[0]
+		tv, ok := pass.TypesInfo.Types[arg]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return
+		}
+		s := constant.StringVal(tv.Value)
+		if check, ok := idCheckers[fn.Name()]; ok && !check.valid(s) {
+			pass.Reportf(arg.Pos(), "%q is not a valid %s", s, check.what)
+		}
+		if parse, ok := tagParsers[fn.Name()]; ok {
+			if err := parse(s); err != nil {
+				pass.Reportf(arg.Pos(), "%v", err)
+			}
+		}
+	})
+	return nil, nil
+}
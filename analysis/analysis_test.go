@@ -0,0 +1,25 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package analysis_test
+
+import (
+	stdtesting "testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names/analysis"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type analysisSuite struct{}
+
+var _ = gc.Suite(&analysisSuite{})
+
+func (s *analysisSuite) TestAnalyzer(c *gc.C) {
+	analysistest.Run(c, analysistest.TestData(), analysis.Analyzer, "a")
+}
@@ -0,0 +1,22 @@
+package a
+
+import "github.com/juju/names"
+
+const app = "mysql"
+
+func f(id string) {
+	names.NewUnitTag("mysql/0")
+	names.NewUnitTag("mysql") // want `"mysql" is not a valid unit name`
+	names.NewUnitTag(app + "/1")
+	names.NewUnitTag(app + "-1") // want `"mysql-1" is not a valid unit name`
+	names.NewUnitTag(id)
+	names.NewMachineTag("0/lxc/1")
+	names.NewMachineTag("0/lxc") // want `"0/lxc" is not a valid machine id`
+	names.NewUserTag("bob@local")
+	names.NewUserTag("b^b")    // want `"b\^b" is not a valid user id`
+	names.UnitService("mysql") // want `"mysql" is not a valid unit name`
+	names.IsValidUnit("mysql")
+	names.ParseTag("unit-mysql-0")
+	names.ParseTag("unit-mysql")    // want `"unit-mysql" is not a valid unit tag`
+	names.ParseUnitTag("machine-0") // want `"machine-0" is not a valid unit tag`
+}
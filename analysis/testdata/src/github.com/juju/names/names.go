@@ -0,0 +1,16 @@
+// Package names is a stub of github.com/juju/names for analyzer tests.
+package names
+
+type Tag interface{}
+
+type UnitTag struct{}
+type MachineTag struct{}
+type UserTag struct{}
+
+func NewUnitTag(string) UnitTag            { return UnitTag{} }
+func NewMachineTag(string) MachineTag      { return MachineTag{} }
+func NewUserTag(string) UserTag            { return UserTag{} }
+func ParseTag(string) (Tag, error)         { return nil, nil }
+func ParseUnitTag(string) (UnitTag, error) { return UnitTag{}, nil }
+func UnitService(string) string            { return "" }
+func IsValidUnit(string) bool              { return false }
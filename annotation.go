@@ -0,0 +1,39 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ParseAnnotatedTag parses a tag that may be followed by an annotation
+// comment, as written in operator-maintained lists of entities:
+//
+//	unit-mysql-0    # primary
+//
+// It returns the tag and the text of the annotation, with the leading
+// "#" and surrounding white space removed. The annotation must be
+// separated from the tag by white space, since "#" may appear within
+// a tag. Surrounding white space is ignored, and input with no
+// annotation is parsed as by ParseTag.
+func ParseAnnotatedTag(s string) (Tag, string, error) {
+	s = strings.TrimSpace(s)
+	tag, annotation := s, ""
+	if i := strings.IndexFunc(s, unicode.IsSpace); i >= 0 {
+		tag, annotation = s[:i], strings.TrimSpace(s[i:])
+	}
+	t, err := ParseTag(tag)
+	if err != nil {
+		return nil, "", err
+	}
+	if annotation == "" {
+		return t, "", nil
+	}
+	if !strings.HasPrefix(annotation, "#") {
+		return nil, "", fmt.Errorf("unexpected text %q after tag %q", annotation, tag)
+	}
+	return t, strings.TrimSpace(annotation[1:]), nil
+}
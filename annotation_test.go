@@ -0,0 +1,58 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type annotationSuite struct{}
+
+var _ = gc.Suite(&annotationSuite{})
+
+var parseAnnotatedTagTests = []struct {
+	input      string
+	tag        names.Tag
+	annotation string
+	err        string
+}{{
+	input: "unit-mysql-0",
+	tag:   names.NewUnitTag("mysql/0"),
+}, {
+	input:      "unit-mysql-0  # primary",
+	tag:        names.NewUnitTag("mysql/0"),
+	annotation: "primary",
+}, {
+	input:      "\tmachine-1\t#rack 3, slot 2  ",
+	tag:        names.NewMachineTag("1"),
+	annotation: "rack 3, slot 2",
+}, {
+	input: "relation-wordpress.db#mysql.server #",
+	tag:   names.NewRelationTag("wordpress:db mysql:server"),
+}, {
+	input: "machine-1 primary",
+	err:   `unexpected text "primary" after tag "machine-1"`,
+}, {
+	input: "unit-mysql # primary",
+	err:   `"unit-mysql" is not a valid unit tag`,
+}, {
+	input: "# just a comment",
+	err:   `"#" is not a valid tag`,
+}}
+
+func (s *annotationSuite) TestParseAnnotatedTag(c *gc.C) {
+	for i, test := range parseAnnotatedTagTests {
+		c.Logf("test %d: %q", i, test.input)
+		tag, annotation, err := names.ParseAnnotatedTag(test.input)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Check(err, gc.IsNil)
+		c.Check(tag, gc.Equals, test.tag)
+		c.Check(annotation, gc.Equals, test.annotation)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+const ApplicationTagKind = "application"
+
+// IsValidApplication returns whether name is a valid application name.
+// Application names follow the same rules as service names.
+func IsValidApplication(name string) bool {
+	return matchService(name)
+}
+
+// ApplicationTag is the successor to ServiceTag: an application is a
+// service under its new name. Use ServiceTag.ApplicationTag and
+// ApplicationTag.ServiceTag to convert between the two, so that
+// clients using either name interoperate.
+type ApplicationTag struct {
+	name string
+}
+
+func (t ApplicationTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ApplicationTag) Kind() string   { return ApplicationTagKind }
+func (t ApplicationTag) Id() string     { return t.name }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ApplicationTag) AppendString(dst []byte) []byte {
+	return append(append(dst, ApplicationTagKind+KindSeparator...), t.name...)
+}
+
+// NewApplicationTag returns the tag for the application with the given
+// name.
+func NewApplicationTag(applicationName string) ApplicationTag {
+	return ApplicationTag{name: applicationName}
+}
+
+// ParseApplicationTag parses an application tag string. Service tags,
+// such as "service-mysql", are accepted too, and are returned as the
+// tag of the application with the same name.
+func ParseApplicationTag(applicationTag string) (ApplicationTag, error) {
+	tag, err := ParseTag(applicationTag)
+	if err != nil {
+		return ApplicationTag{}, err
+	}
+	switch t := tag.(type) {
+	case ApplicationTag:
+		return t, nil
+	case ServiceTag:
+		return t.ApplicationTag(), nil
+	}
+	return ApplicationTag{}, invalidTagError(applicationTag, ApplicationTagKind)
+}
+
+// ServiceTag returns the tag of the service with the same name as the
+// application.
+func (t ApplicationTag) ServiceTag() ServiceTag {
+	return ServiceTag{Name: t.name}
+}
+
+// ApplicationTag returns the tag of the application with the same name
+// as the service.
+func (t ServiceTag) ApplicationTag() ApplicationTag {
+	return ApplicationTag{name: t.Name}
+}
@@ -0,0 +1,73 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type applicationSuite struct{}
+
+var _ = gc.Suite(&applicationSuite{})
+
+var parseApplicationTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "application-dave",
+	expected: names.NewApplicationTag("dave"),
+}, {
+	tag:      "service-dave",
+	expected: names.NewApplicationTag("dave"),
+}, {
+	tag: "application-dave-0",
+	err: names.InvalidTagError("application-dave-0", names.ApplicationTagKind),
+}, {
+	tag: "service-dave-0",
+	err: names.InvalidTagError("service-dave-0", names.ServiceTagKind),
+}, {
+	tag: "dave",
+	err: names.InvalidTagError("dave", ""),
+}, {
+	tag: "unit-dave-0",
+	err: names.InvalidTagError("unit-dave-0", names.ApplicationTagKind),
+}}
+
+func (s *applicationSuite) TestParseApplicationTag(c *gc.C) {
+	for i, t := range parseApplicationTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseApplicationTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+func (s *applicationSuite) TestIsValidApplication(c *gc.C) {
+	c.Check(names.IsValidApplication("wordpress"), gc.Equals, true)
+	c.Check(names.IsValidApplication("mysql-2"), gc.Equals, false)
+	c.Check(names.IsValidApplication(""), gc.Equals, false)
+}
+
+func (s *applicationSuite) TestServiceTagConversion(c *gc.C) {
+	application := names.NewApplicationTag("wordpress")
+	service := names.NewServiceTag("wordpress")
+	c.Check(application.ServiceTag(), gc.Equals, service)
+	c.Check(service.ApplicationTag(), gc.Equals, application)
+	c.Check(application.String(), gc.Equals, "application-wordpress")
+	c.Check(application.ServiceTag().String(), gc.Equals, "service-wordpress")
+
+	// ParseServiceTag does not accept application tags.
+	_, err := names.ParseServiceTag("application-wordpress")
+	c.Check(err, gc.ErrorMatches, `"application-wordpress" is not a valid service tag`)
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ApplicationOfferTagKind = "applicationoffer"
+
+// IsValidOfferName returns whether name is a valid application offer
+// name. Offer names follow the same rules as application names.
+func IsValidOfferName(name string) bool {
+	return matchService(name)
+}
+
+// IsValidApplicationOffer returns whether id is a valid application
+// offer id: the UUID of the offer, the offer's name, or its name
+// qualified by the owner and name of the offering environment, such
+// as "bob/prod.mysql".
+func IsValidApplicationOffer(id string) bool {
+	if _, ok := parseUUID(id); ok {
+		return true
+	}
+	_, ok := splitOfferName(id)
+	return ok
+}
+
+// splitOfferName returns the unqualified offer name in id, which is an
+// offer name, optionally qualified as <owner>/<environment>.<name>.
+func splitOfferName(id string) (string, bool) {
+	i := strings.Index(id, "/")
+	if i < 0 {
+		if !IsValidOfferName(id) {
+			return "", false
+		}
+		return id, true
+	}
+	j := strings.LastIndex(id, ".")
+	if j < i || !IsValidUser(id[:i]) || !matchEnvironName(id[i+1:j]) || !IsValidOfferName(id[j+1:]) {
+		return "", false
+	}
+	return id[j+1:], true
+}
+
+// ApplicationOfferTag represents an application offered for use by
+// other environments in cross-environment relations.
+type ApplicationOfferTag struct {
+	id string
+}
+
+func (t ApplicationOfferTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ApplicationOfferTag) Kind() string   { return ApplicationOfferTagKind }
+func (t ApplicationOfferTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ApplicationOfferTag) AppendString(dst []byte) []byte {
+	return append(append(dst, ApplicationOfferTagKind+KindSeparator...), t.id...)
+}
+
+// OfferName returns the unqualified name of the offer, or the empty
+// string if the offer is identified by its UUID.
+func (t ApplicationOfferTag) OfferName() string {
+	if _, ok := parseUUID(t.id); ok {
+		return ""
+	}
+	name, _ := splitOfferName(t.id)
+	return name
+}
+
+// NewApplicationOfferTag returns the tag of the application offer with
+// the given id. It will panic if the given id is not valid.
+func NewApplicationOfferTag(id string) ApplicationOfferTag {
+	if !IsValidApplicationOffer(id) {
+		panic(fmt.Sprintf("%q is not a valid application offer id", id))
+	}
+	return ApplicationOfferTag{id: id}
+}
+
+// ParseApplicationOfferTag parses an application offer tag string.
+func ParseApplicationOfferTag(applicationOfferTag string) (ApplicationOfferTag, error) {
+	tag, err := ParseTag(applicationOfferTag)
+	if err != nil {
+		return ApplicationOfferTag{}, err
+	}
+	ot, ok := tag.(ApplicationOfferTag)
+	if !ok {
+		return ApplicationOfferTag{}, invalidTagError(applicationOfferTag, ApplicationOfferTagKind)
+	}
+	return ot, nil
+}
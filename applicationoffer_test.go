@@ -0,0 +1,98 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type applicationOfferSuite struct{}
+
+var _ = gc.Suite(&applicationOfferSuite{})
+
+var applicationOfferTests = []struct {
+	id        string
+	valid     bool
+	offerName string
+}{
+	{id: "f47ac10b-58cc-4372-a567-0e02b2c3d479", valid: true},
+	{id: "abcdefab-abcd-abcd-abcd-abcdefabcdef", valid: true},
+	{id: "mysql", valid: true, offerName: "mysql"},
+	{id: "hosted-mysql", valid: true, offerName: "hosted-mysql"},
+	{id: "bob/prod.mysql", valid: true, offerName: "mysql"},
+	{id: "bob@local/prod-2.mysql", valid: true, offerName: "mysql"},
+	{id: ""},
+	{id: "mysql-0"},
+	{id: "MySQL"},
+	{id: "f47ac10b-58cc-4372-a567"},
+	{id: "xf47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	{id: "bob/prod"},
+	{id: "bob/.mysql"},
+	{id: "/prod.mysql"},
+	{id: "b^b/prod.mysql"},
+	{id: "bob/prod.mysql-0"},
+	{id: "bob.prod/mysql"},
+}
+
+func (s *applicationOfferSuite) TestApplicationOfferTag(c *gc.C) {
+	for i, test := range applicationOfferTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidApplicationOffer(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid application offer id", test.id)
+			testTag := func() { names.NewApplicationOfferTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewApplicationOfferTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, "applicationoffer-"+test.id)
+		c.Check(tag.OfferName(), gc.Equals, test.offerName)
+		parsed, err := names.ParseApplicationOfferTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseApplicationOfferTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "applicationoffer-mysql",
+	expected: names.NewApplicationOfferTag("mysql"),
+}, {
+	tag:      "applicationoffer-bob/prod.mysql",
+	expected: names.NewApplicationOfferTag("bob/prod.mysql"),
+}, {
+	tag: "applicationoffer-mysql/0",
+	err: names.InvalidTagError("applicationoffer-mysql/0", names.ApplicationOfferTagKind),
+}, {
+	tag: "applicationoffer",
+	err: names.InvalidTagError("applicationoffer", ""),
+}, {
+	tag: "application-mysql",
+	err: names.InvalidTagError("application-mysql", names.ApplicationOfferTagKind),
+}}
+
+func (s *applicationOfferSuite) TestParseApplicationOfferTag(c *gc.C) {
+	for i, t := range parseApplicationOfferTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseApplicationOfferTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const AuditEntryTagKind = "auditentry"
+
+// IsValidAuditEntry returns whether id is a valid audit entry id:
+// either a sequence number without leading zeros, such as "42", or a
+// UUID.
+func IsValidAuditEntry(id string) bool {
+	if _, ok := parseUUID(id); ok {
+		return true
+	}
+	return matchNumber(id)
+}
+
+// AuditEntryTag represents an entry in an audit log.
+type AuditEntryTag struct {
+	id string
+}
+
+func (t AuditEntryTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t AuditEntryTag) Kind() string   { return AuditEntryTagKind }
+func (t AuditEntryTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t AuditEntryTag) AppendString(dst []byte) []byte {
+	return append(append(dst, AuditEntryTagKind+KindSeparator...), t.id...)
+}
+
+// NewAuditEntryTag returns the tag of the audit entry with the given
+// id. It will panic if the given id is not valid.
+func NewAuditEntryTag(id string) AuditEntryTag {
+	if !IsValidAuditEntry(id) {
+		panic(fmt.Sprintf("%q is not a valid audit entry id", id))
+	}
+	return AuditEntryTag{id: id}
+}
+
+// ParseAuditEntryTag parses an audit entry tag string.
+func ParseAuditEntryTag(auditEntryTag string) (AuditEntryTag, error) {
+	tag, err := ParseTag(auditEntryTag)
+	if err != nil {
+		return AuditEntryTag{}, err
+	}
+	at, ok := tag.(AuditEntryTag)
+	if !ok {
+		return AuditEntryTag{}, invalidTagError(auditEntryTag, AuditEntryTagKind)
+	}
+	return at, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type auditEntrySuite struct{}
+
+var _ = gc.Suite(&auditEntrySuite{})
+
+func (s *auditEntrySuite) TestIsValidAuditEntry(c *gc.C) {
+	c.Check(names.IsValidAuditEntry("0"), gc.Equals, true)
+	c.Check(names.IsValidAuditEntry("42"), gc.Equals, true)
+	c.Check(names.IsValidAuditEntry("f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, true)
+	c.Check(names.IsValidAuditEntry("042"), gc.Equals, false)
+	c.Check(names.IsValidAuditEntry("-1"), gc.Equals, false)
+	c.Check(names.IsValidAuditEntry("F47AC10B-58CC-4372-A567-0E02B2C3D479"), gc.Equals, false)
+	c.Check(names.IsValidAuditEntry("f47ac10b"), gc.Equals, false)
+	c.Check(names.IsValidAuditEntry(""), gc.Equals, false)
+	c.Check(func() { names.NewAuditEntryTag("042") }, gc.PanicMatches, `"042" is not a valid audit entry id`)
+}
+
+var parseAuditEntryTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "auditentry-42",
+	expected: names.NewAuditEntryTag("42"),
+}, {
+	tag:      "auditentry-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewAuditEntryTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "auditentry-042",
+	err: names.InvalidTagError("auditentry-042", names.AuditEntryTagKind),
+}, {
+	tag: "auditentry",
+	err: names.InvalidTagError("auditentry", ""),
+}, {
+	tag: "operation-42",
+	err: names.InvalidTagError("operation-42", names.AuditEntryTagKind),
+}}
+
+func (s *auditEntrySuite) TestParseAuditEntryTag(c *gc.C) {
+	for i, t := range parseAuditEntryTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseAuditEntryTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
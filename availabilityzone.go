@@ -0,0 +1,59 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const AvailabilityZoneTagKind = "zone"
+
+// AvailabilityZoneSnippet matches an availability zone name as used by
+// cloud providers: letters and digits, optionally separated by single
+// hyphens, underscores or dots, such as "us-east-1a" or "nova".
+const AvailabilityZoneSnippet = "(?:[a-zA-Z0-9]+(?:[-_.][a-zA-Z0-9]+)*)"
+
+// IsValidAvailabilityZone reports whether name is a valid availability
+// zone name.
+func IsValidAvailabilityZone(name string) bool {
+	return matchAvailabilityZone(name)
+}
+
+// AvailabilityZoneTag represents an availability zone of a cloud
+// provider, for use in placement and distribution of units.
+type AvailabilityZoneTag struct {
+	name string
+}
+
+func (t AvailabilityZoneTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t AvailabilityZoneTag) Kind() string   { return AvailabilityZoneTagKind }
+func (t AvailabilityZoneTag) Id() string     { return t.name }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t AvailabilityZoneTag) AppendString(dst []byte) []byte {
+	return append(append(dst, AvailabilityZoneTagKind+KindSeparator...), t.name...)
+}
+
+// NewAvailabilityZoneTag returns the tag of the availability zone with
+// the given name. It will panic if the given name is not valid.
+func NewAvailabilityZoneTag(name string) AvailabilityZoneTag {
+	if !IsValidAvailabilityZone(name) {
+		panic(fmt.Sprintf("%q is not a valid availability zone name", name))
+	}
+	return AvailabilityZoneTag{name: name}
+}
+
+// ParseAvailabilityZoneTag parses an availability zone tag string.
+func ParseAvailabilityZoneTag(zoneTag string) (AvailabilityZoneTag, error) {
+	tag, err := ParseTag(zoneTag)
+	if err != nil {
+		return AvailabilityZoneTag{}, err
+	}
+	zt, ok := tag.(AvailabilityZoneTag)
+	if !ok {
+		return AvailabilityZoneTag{}, invalidTagError(zoneTag, AvailabilityZoneTagKind)
+	}
+	return zt, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type availabilityZoneSuite struct{}
+
+var _ = gc.Suite(&availabilityZoneSuite{})
+
+var availabilityZoneNameTests = []struct {
+	name  string
+	valid bool
+}{
+	{name: "us-east-1a", valid: true},
+	{name: "europe-west1-b", valid: true},
+	{name: "nova", valid: true},
+	{name: "1", valid: true},
+	{name: "zone_1.rack2", valid: true},
+	{name: "Zone1", valid: true},
+	{name: ""},
+	{name: "-zone"},
+	{name: "zone-"},
+	{name: "zone--1"},
+	{name: "zone-.1"},
+	{name: "zone 1"},
+	{name: "zone/1"},
+}
+
+func (s *availabilityZoneSuite) TestAvailabilityZoneTag(c *gc.C) {
+	for i, test := range availabilityZoneNameTests {
+		c.Logf("test %d: %q", i, test.name)
+		c.Check(names.IsValidAvailabilityZone(test.name), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid availability zone name", test.name)
+			testTag := func() { names.NewAvailabilityZoneTag(test.name) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewAvailabilityZoneTag(test.name)
+		c.Check(tag.Id(), gc.Equals, test.name)
+		c.Check(tag.String(), gc.Equals, "zone-"+test.name)
+	}
+}
+
+var parseAvailabilityZoneTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "zone-us-east-1a",
+	expected: names.NewAvailabilityZoneTag("us-east-1a"),
+}, {
+	tag: "zone-",
+	err: names.InvalidTagError("zone-", names.AvailabilityZoneTagKind),
+}, {
+	tag: "zone-us--east",
+	err: names.InvalidTagError("zone-us--east", names.AvailabilityZoneTagKind),
+}, {
+	tag: "cloud-aws",
+	err: names.InvalidTagError("cloud-aws", names.AvailabilityZoneTagKind),
+}}
+
+func (s *availabilityZoneSuite) TestParseAvailabilityZoneTag(c *gc.C) {
+	for i, t := range parseAvailabilityZoneTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseAvailabilityZoneTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const BackupTagKind = "backup"
+
+// BackupTimeLayout is the layout, as used by the time package, of the
+// timestamp at the start of a backup id. Timestamps are in UTC.
+const BackupTimeLayout = "20060102-150405"
+
+// IsValidBackup returns whether id is a valid backup id: the UTC time
+// the backup was made, in BackupTimeLayout, and a UUID making it unique,
+// separated by a dot, such as
+// "20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func IsValidBackup(id string) bool {
+	_, _, ok := splitBackupId(id)
+	return ok
+}
+
+// splitBackupId splits a backup id into its time and UUID, validating
+// both.
+func splitBackupId(id string) (time.Time, string, bool) {
+	timestamp, uuid, ok := strings.Cut(id, ".")
+	if !ok {
+		return time.Time{}, "", false
+	}
+	t, err := time.Parse(BackupTimeLayout, timestamp)
+	// The layout allows some timestamps, such as those with
+	// single-digit hours, that it would not produce.
+	if err != nil || t.Format(BackupTimeLayout) != timestamp {
+		return time.Time{}, "", false
+	}
+	if _, ok := parseUUID(uuid); !ok {
+		return time.Time{}, "", false
+	}
+	return t, uuid, true
+}
+
+// BackupTag represents a backup archive. Because backup ids start with
+// a fixed-width timestamp, sorting backup ids or tags as strings sorts
+// them by the time the backups were made.
+type BackupTag struct {
+	id string
+}
+
+func (t BackupTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t BackupTag) Kind() string   { return BackupTagKind }
+func (t BackupTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t BackupTag) AppendString(dst []byte) []byte {
+	return append(append(dst, BackupTagKind+KindSeparator...), t.id...)
+}
+
+// Time returns the UTC time the backup was made, to the second. It
+// returns the zero time for the zero tag.
+func (t BackupTag) Time() time.Time {
+	backupTime, _, _ := splitBackupId(t.id)
+	return backupTime
+}
+
+// UUID returns the UUID that distinguishes the backup from others made
+// at the same time.
+func (t BackupTag) UUID() string {
+	_, uuid, _ := splitBackupId(t.id)
+	return uuid
+}
+
+// NewBackupTag returns the tag of the backup with the given id. It will
+// panic if the given id is not valid.
+func NewBackupTag(id string) BackupTag {
+	if !IsValidBackup(id) {
+		panic(fmt.Sprintf("%q is not a valid backup id", id))
+	}
+	return BackupTag{id: id}
+}
+
+// JoinBackupTag returns the tag of the backup made at the given time,
+// truncated to the second, and distinguished by the given UUID. It will
+// panic if the UUID is not valid.
+func JoinBackupTag(t time.Time, uuid string) BackupTag {
+	return NewBackupTag(t.UTC().Format(BackupTimeLayout) + "." + uuid)
+}
+
+// ParseBackupTag parses a backup tag string.
+func ParseBackupTag(backupTag string) (BackupTag, error) {
+	tag, err := ParseTag(backupTag)
+	if err != nil {
+		return BackupTag{}, err
+	}
+	bt, ok := tag.(BackupTag)
+	if !ok {
+		return BackupTag{}, invalidTagError(backupTag, BackupTagKind)
+	}
+	return bt, nil
+}
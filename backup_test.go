@@ -0,0 +1,125 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type backupSuite struct{}
+
+var _ = gc.Suite(&backupSuite{})
+
+const backupUUID = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+var backupIdTests = []struct {
+	id    string
+	valid bool
+	time  time.Time
+}{
+	{id: "20240101-123456." + backupUUID, valid: true, time: time.Date(2024, 1, 1, 12, 34, 56, 0, time.UTC)},
+	{id: "19991231-235959." + backupUUID, valid: true, time: time.Date(1999, 12, 31, 23, 59, 59, 0, time.UTC)},
+	{id: ""},
+	{id: "20240101-123456"},
+	{id: "20240101-123456."},
+	{id: "." + backupUUID},
+	{id: "20240101123456." + backupUUID},
+	{id: "20241301-123456." + backupUUID},
+	{id: "20240230-123456." + backupUUID},
+	{id: "20240101-243456." + backupUUID},
+	{id: "2024011-123456." + backupUUID},
+	{id: "20240101-123456.f47ac10b"},
+	{id: "20240101-123456." + backupUUID + "x"},
+	{id: "20240101-123456-" + backupUUID},
+}
+
+func (s *backupSuite) TestBackupTag(c *gc.C) {
+	for i, test := range backupIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidBackup(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid backup id", test.id)
+			testTag := func() { names.NewBackupTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewBackupTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, "backup-"+test.id)
+		c.Check(tag.Time().Equal(test.time), gc.Equals, true)
+		c.Check(tag.Time().Location(), gc.Equals, time.UTC)
+		c.Check(tag.UUID(), gc.Equals, backupUUID)
+	}
+}
+
+func (s *backupSuite) TestZeroBackupTag(c *gc.C) {
+	var tag names.BackupTag
+	c.Check(tag.Time().IsZero(), gc.Equals, true)
+	c.Check(tag.UUID(), gc.Equals, "")
+}
+
+func (s *backupSuite) TestJoinBackupTag(c *gc.C) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	t := time.Date(2024, 1, 1, 14, 34, 56, 789, loc)
+	tag := names.JoinBackupTag(t, backupUUID)
+	c.Check(tag, gc.Equals, names.NewBackupTag("20240101-123456."+backupUUID))
+	c.Check(tag.Time().Equal(t.Truncate(time.Second)), gc.Equals, true)
+
+	testTag := func() { names.JoinBackupTag(t, "bad") }
+	c.Check(testTag, gc.PanicMatches, `"20240101-123456.bad" is not a valid backup id`)
+}
+
+func (s *backupSuite) TestBackupTagsSortByTime(c *gc.C) {
+	start := time.Date(2023, 12, 31, 23, 59, 58, 0, time.UTC)
+	var tags []string
+	for _, d := range []time.Duration{time.Hour, 0, 24 * time.Hour, time.Second, 365 * 24 * time.Hour} {
+		tags = append(tags, names.JoinBackupTag(start.Add(d), backupUUID).String())
+	}
+	sort.Strings(tags)
+	for i := 1; i < len(tags); i++ {
+		prev, err := names.ParseBackupTag(tags[i-1])
+		c.Assert(err, gc.IsNil)
+		next, err := names.ParseBackupTag(tags[i])
+		c.Assert(err, gc.IsNil)
+		c.Check(prev.Time().Before(next.Time()), gc.Equals, true)
+	}
+}
+
+var parseBackupTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "backup-20240101-123456." + backupUUID,
+	expected: names.NewBackupTag("20240101-123456." + backupUUID),
+}, {
+	tag: "backup-20240101-123456",
+	err: names.InvalidTagError("backup-20240101-123456", names.BackupTagKind),
+}, {
+	tag: "environment-" + backupUUID,
+	err: names.InvalidTagError("environment-"+backupUUID, names.BackupTagKind),
+}}
+
+func (s *backupSuite) TestParseBackupTag(c *gc.C) {
+	for i, t := range parseBackupTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseBackupTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
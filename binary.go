@@ -0,0 +1,97 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// kindCodes holds the codes that identify tag kinds in the binary
+// encoding of tags: the code of a kind is its index. Encoded tags are
+// stored and exchanged between versions, so the table is append-only:
+// never remove, reorder or reuse an entry. Code 0 is not used.
+var kindCodes = []string{
+	"",
+	UnitTagKind,
+	MachineTagKind,
+	ServiceTagKind,
+	EnvironTagKind,
+	UserTagKind,
+	RelationTagKind,
+	NetworkTagKind,
+	ActionTagKind,
+	ActionResultTagKind,
+	VolumeTagKind,
+	FilesystemTagKind,
+	StorageTagKind,
+	StorageAttachmentTagKind,
+	VolumeAttachmentTagKind,
+	FilesystemAttachmentTagKind,
+	SpaceTagKind,
+	SubnetTagKind,
+	IPAddressTagKind,
+	ModelTagKind,
+	ControllerTagKind,
+	ControllerAgentTagKind,
+	CloudTagKind,
+	CloudCredentialTagKind,
+	ApplicationTagKind,
+	ApplicationOfferTagKind,
+	RemoteApplicationTagKind,
+	RemoteRelationTagKind,
+	OperationTagKind,
+	SecretTagKind,
+	SecretBackendTagKind,
+	CharmTagKind,
+	ResourceTagKind,
+	MetricBatchTagKind,
+	InstanceTagKind,
+	AvailabilityZoneTagKind,
+	CloudRegionTagKind,
+	ImageTagKind,
+	SSHKeyTagKind,
+	CertificateTagKind,
+	BackupTagKind,
+	UpgradeTagKind,
+	MigrationTagKind,
+	LeaseTagKind,
+	AuditEntryTagKind,
+	TokenTagKind,
+}
+
+// kindCode returns the binary encoding code of kind.
+func kindCode(kind string) (uint64, bool) {
+	for code, k := range kindCodes {
+		if code > 0 && k == kind {
+			return uint64(code), true
+		}
+	}
+	return 0, false
+}
+
+// appendBinaryTag appends the binary encoding of the tag with the given
+// kind and id to dst: the kind code as an unsigned varint, followed by
+// the id.
+func appendBinaryTag(dst []byte, kind, id string) ([]byte, error) {
+	code, ok := kindCode(kind)
+	if !ok {
+		return nil, fmt.Errorf("no binary encoding for %q tags", kind)
+	}
+	dst = binary.AppendUvarint(dst, code)
+	return append(dst, id...), nil
+}
+
+// binaryTagString returns the string representation of the tag with
+// the binary encoding held in data.
+func binaryTagString(data []byte) (string, error) {
+	code, n := binary.Uvarint(data)
+	if n <= 0 {
+		return "", fmt.Errorf("cannot decode binary tag: invalid kind code")
+	}
+	if code == 0 || code >= uint64(len(kindCodes)) {
+		return "", fmt.Errorf("cannot decode binary tag: unknown kind code %d", code)
+	}
+	return string(AppendKindId(nil, kindCodes[code], string(data[n:]))), nil
+}
@@ -0,0 +1,29 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"bytes"
+)
+
+// ParseTagBytes is like ParseTag but parses a tag held in a byte
+// slice, such as one read from the network, without first converting
+// all of it to a string. Only the part following the kind is copied,
+// and the returned tag does not refer to data, which the caller may
+// reuse.
+func ParseTagBytes(data []byte) (Tag, error) {
+	if defaultParser.Load() != nil {
+		// Hooks of the default parser are given the whole tag.
+		return ParseTag(string(data))
+	}
+	if i := bytes.Index(data, []byte(KindSeparator)); i > 0 {
+		// The kind is only compared with the known kinds, so its
+		// conversion does not need to allocate.
+		if t, ok := parseTagSuffix(string(data[:i]), string(data[i+len(KindSeparator):])); ok {
+			return t, nil
+		}
+	}
+	// Errors hold a quoted copy of the tag.
+	return parseTag(string(data))
+}
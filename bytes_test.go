@@ -0,0 +1,61 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"testing"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type bytesSuite struct{}
+
+var _ = gc.Suite(&bytesSuite{})
+
+func (s *bytesSuite) TestParseTagBytes(c *gc.C) {
+	for i, test := range parseTagTests {
+		c.Logf("test %d: %s", i, test.tag)
+		expect, expectErr := names.ParseTag(test.tag)
+		data := []byte(test.tag)
+		tag, err := names.ParseTagBytes(data)
+		c.Check(tag, gc.Equals, expect)
+		c.Check(err, gc.DeepEquals, expectErr)
+
+		// The tag and error must not change when the data is reused.
+		for j := range data {
+			data[j] = 'x'
+		}
+		c.Check(tag, gc.Equals, expect)
+		c.Check(err, gc.DeepEquals, expectErr)
+	}
+	_, err := names.ParseTagBytes(nil)
+	c.Check(err, gc.ErrorMatches, `"" is not a valid tag`)
+}
+
+func (s *bytesSuite) TestParseTagBytesAllKinds(c *gc.C) {
+	for i, test := range encodingTags {
+		if _, ok := test.tag.(names.AgentTag); ok {
+			continue
+		}
+		c.Logf("test %d: %s", i, test.tag)
+		data := []byte(test.tag.String())
+		tag, err := names.ParseTagBytes(data)
+		c.Assert(err, gc.IsNil)
+		for j := range data {
+			data[j] = 'x'
+		}
+		c.Check(tag, gc.Equals, test.tag)
+	}
+}
+
+func (s *bytesSuite) TestParseTagBytesCopiesOnlyId(c *gc.C) {
+	const tag = "application-wordpress-with-a-longer-than-usual-name"
+	data := []byte(tag)
+	parse := testing.AllocsPerRun(100, func() { names.ParseTag(tag) })
+	parseBytes := testing.AllocsPerRun(100, func() { names.ParseTagBytes(data) })
+	// The only allocation beyond those of ParseTag is that of the id.
+	c.Check(parseBytes, gc.Equals, parse+1)
+}
@@ -0,0 +1,128 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const CertificateTagKind = "certificate"
+
+// certificateFingerprintSize is the number of bytes in a certificate
+// fingerprint: the SHA-256 digest of its DER encoding.
+const certificateFingerprintSize = 32
+
+// maxCertificateSerialLength is the maximum number of hexadecimal
+// digits in a certificate serial number, which RFC 5280 limits to 20
+// octets.
+const maxCertificateSerialLength = 40
+
+// IsValidCertificate returns whether id is a valid certificate id:
+// either the certificate's SHA-256 fingerprint, as 32 colon-separated
+// hexadecimal bytes, or its serial number, as up to 40 hexadecimal
+// digits. Upper and lower case digits are both valid.
+func IsValidCertificate(id string) bool {
+	_, ok := canonicalCertificateId(id)
+	return ok
+}
+
+// canonicalCertificateId returns the canonical form of a certificate
+// id: fingerprints and serial numbers in lower case, and serial numbers
+// without leading zeros.
+func canonicalCertificateId(id string) (string, bool) {
+	id = strings.ToLower(id)
+	if strings.Contains(id, ":") {
+		parts := strings.Split(id, ":")
+		if len(parts) != certificateFingerprintSize {
+			return "", false
+		}
+		for _, part := range parts {
+			if len(part) != 2 || !isLowerHexString(part) {
+				return "", false
+			}
+		}
+		return id, true
+	}
+	if id == "" || len(id) > maxCertificateSerialLength || !isLowerHexString(id) {
+		return "", false
+	}
+	if serial := strings.TrimLeft(id, "0"); serial != "" {
+		return serial, true
+	}
+	return "0", true
+}
+
+// isLowerHexString returns whether s consists only of lower case
+// hexadecimal digits.
+func isLowerHexString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !('0' <= s[i] && s[i] <= '9' || 'a' <= s[i] && s[i] <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// CertificateTag represents an X.509 certificate, identified by its
+// fingerprint or serial number.
+type CertificateTag struct {
+	id string
+}
+
+func (t CertificateTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t CertificateTag) Kind() string   { return CertificateTagKind }
+func (t CertificateTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t CertificateTag) AppendString(dst []byte) []byte {
+	return append(append(dst, CertificateTagKind+KindSeparator...), t.id...)
+}
+
+// Fingerprint returns the SHA-256 fingerprint identifying the
+// certificate, and whether the certificate is identified by its
+// fingerprint.
+func (t CertificateTag) Fingerprint() (string, bool) {
+	if !strings.Contains(t.id, ":") {
+		return "", false
+	}
+	return t.id, true
+}
+
+// Serial returns the hexadecimal serial number identifying the
+// certificate, and whether the certificate is identified by its serial
+// number.
+func (t CertificateTag) Serial() (string, bool) {
+	if t.id == "" || strings.Contains(t.id, ":") {
+		return "", false
+	}
+	return t.id, true
+}
+
+// NewCertificateTag returns the tag of the certificate with the given
+// fingerprint or serial number. The tag's id is the canonical form of
+// the id, so that, for example, "00A1" yields the tag "certificate-a1".
+// It will panic if the given id is not valid.
+func NewCertificateTag(id string) CertificateTag {
+	canonical, ok := canonicalCertificateId(id)
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid certificate id", id))
+	}
+	return CertificateTag{id: canonical}
+}
+
+// ParseCertificateTag parses a certificate tag string. Only tags
+// holding the canonical form of an id are valid.
+func ParseCertificateTag(certificateTag string) (CertificateTag, error) {
+	tag, err := ParseTag(certificateTag)
+	if err != nil {
+		return CertificateTag{}, err
+	}
+	ct, ok := tag.(CertificateTag)
+	if !ok {
+		return CertificateTag{}, invalidTagError(certificateTag, CertificateTagKind)
+	}
+	return ct, nil
+}
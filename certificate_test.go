@@ -0,0 +1,116 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type certificateSuite struct{}
+
+var _ = gc.Suite(&certificateSuite{})
+
+const certificateFingerprint = "3a:7b:0c:d1:92:e4:55:f6:07:18:a9:2b:cc:4d:e0:f1" +
+	":12:23:34:45:56:67:78:89:9a:ab:bc:cd:de:ef:f0:01"
+
+var certificateIdTests = []struct {
+	id          string
+	canonical   string
+	fingerprint bool
+}{
+	{id: certificateFingerprint, canonical: certificateFingerprint, fingerprint: true},
+	{id: strings.ToUpper(certificateFingerprint), canonical: certificateFingerprint, fingerprint: true},
+	{id: "a1", canonical: "a1"},
+	{id: "00A1", canonical: "a1"},
+	{id: "000", canonical: "0"},
+	{id: "1234567890abcdef1234567890abcdef12345678", canonical: "1234567890abcdef1234567890abcdef12345678"},
+	{id: ""},
+	{id: "1234567890abcdef1234567890abcdef123456789"},
+	{id: "a1g"},
+	{id: "-a1"},
+	{id: certificateFingerprint[3:]},
+	{id: certificateFingerprint + ":02"},
+	{id: "3a:7b"},
+	{id: strings.Replace(certificateFingerprint, "3a", "3", 1)},
+	{id: strings.Replace(certificateFingerprint, "3a", "3x", 1)},
+}
+
+func (s *certificateSuite) TestCertificateTag(c *gc.C) {
+	for i, test := range certificateIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		valid := test.canonical != ""
+		c.Check(names.IsValidCertificate(test.id), gc.Equals, valid)
+		if !valid {
+			expectErr := fmt.Sprintf("%q is not a valid certificate id", test.id)
+			testTag := func() { names.NewCertificateTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewCertificateTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.canonical)
+		fingerprint, ok := tag.Fingerprint()
+		c.Check(ok, gc.Equals, test.fingerprint)
+		serial, ok := tag.Serial()
+		c.Check(ok, gc.Equals, !test.fingerprint)
+		if test.fingerprint {
+			c.Check(fingerprint, gc.Equals, test.canonical)
+		} else {
+			c.Check(serial, gc.Equals, test.canonical)
+		}
+	}
+}
+
+func (s *certificateSuite) TestZeroCertificateTag(c *gc.C) {
+	var tag names.CertificateTag
+	_, ok := tag.Fingerprint()
+	c.Check(ok, gc.Equals, false)
+	_, ok = tag.Serial()
+	c.Check(ok, gc.Equals, false)
+}
+
+var parseCertificateTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "certificate-" + certificateFingerprint,
+	expected: names.NewCertificateTag(certificateFingerprint),
+}, {
+	tag:      "certificate-a1",
+	expected: names.NewCertificateTag("a1"),
+}, {
+	tag: "certificate-00a1",
+	err: names.InvalidTagError("certificate-00a1", names.CertificateTagKind),
+}, {
+	tag: "certificate-A1",
+	err: names.InvalidTagError("certificate-A1", names.CertificateTagKind),
+}, {
+	tag: "certificate-",
+	err: names.InvalidTagError("certificate-", names.CertificateTagKind),
+}, {
+	tag: "image-a1",
+	err: names.InvalidTagError("image-a1", names.CertificateTagKind),
+}}
+
+func (s *certificateSuite) TestParseCertificateTag(c *gc.C) {
+	for i, t := range parseCertificateTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseCertificateTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
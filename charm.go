@@ -0,0 +1,206 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const CharmTagKind = "charm"
+
+// Charm URL schemas.
+const (
+	CharmStoreSchema = "cs"
+	LocalCharmSchema = "local"
+)
+
+// CharmURL identifies a charm. Its string form is
+//
+//	<schema>:[~<user>/][<series>/]<name>[-<revision>]
+//
+// such as "cs:~bob/trusty/wordpress-3" or "local:mysql". Only charm
+// store URLs may name a user.
+type CharmURL struct {
+	schema   string
+	user     string
+	series   string
+	name     string
+	revision int
+}
+
+// ParseCharmURL parses the string form of a charm URL.
+func ParseCharmURL(s string) (CharmURL, error) {
+	u, err := parseCharmURL(s)
+	if err != nil {
+		return CharmURL{}, fmt.Errorf("%q is not a valid charm URL: %v", s, err)
+	}
+	return u, nil
+}
+
+func parseCharmURL(s string) (CharmURL, error) {
+	u := CharmURL{revision: -1}
+	schema, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return CharmURL{}, fmt.Errorf("no schema")
+	}
+	switch schema {
+	case CharmStoreSchema, LocalCharmSchema:
+		u.schema = schema
+	default:
+		return CharmURL{}, fmt.Errorf("unknown schema %q", schema)
+	}
+	parts := strings.Split(rest, "/")
+	if strings.HasPrefix(parts[0], "~") {
+		if schema != CharmStoreSchema {
+			return CharmURL{}, fmt.Errorf("user not allowed in %s URL", schema)
+		}
+		u.user = parts[0][1:]
+		if !matchUserName(u.user) {
+			return CharmURL{}, fmt.Errorf("invalid user %q", u.user)
+		}
+		parts = parts[1:]
+	}
+	switch len(parts) {
+	case 1:
+	case 2:
+		u.series = parts[0]
+		if !IsValidCharmSeries(u.series) {
+			return CharmURL{}, fmt.Errorf("invalid series %q", u.series)
+		}
+		parts = parts[1:]
+	default:
+		return CharmURL{}, fmt.Errorf("too many path segments")
+	}
+	u.name = parts[0]
+	// A trailing hyphen-separated number is the revision; each part of
+	// a charm name contains a letter, so it cannot be mistaken for one.
+	if i := strings.LastIndex(u.name, "-"); i >= 0 && matchNumber(u.name[i+1:]) {
+		revision, err := strconv.Atoi(u.name[i+1:])
+		if err != nil {
+			return CharmURL{}, fmt.Errorf("invalid revision %q", u.name[i+1:])
+		}
+		u.name, u.revision = u.name[:i], revision
+	}
+	if !IsValidCharmName(u.name) {
+		return CharmURL{}, fmt.Errorf("invalid name %q", u.name)
+	}
+	return u, nil
+}
+
+// IsValidCharmURL returns whether s is the string form of a valid
+// charm URL.
+func IsValidCharmURL(s string) bool {
+	u, err := parseCharmURL(s)
+	return err == nil && u.String() == s
+}
+
+// IsValidCharmName returns whether name is a valid charm name. Charm
+// names follow the same rules as service names.
+func IsValidCharmName(name string) bool {
+	return matchService(name)
+}
+
+// IsValidCharmSeries returns whether series is a valid charm series:
+// lower-case letters, optionally followed by lower-case letters and
+// digits, such as "trusty" or "win2012r2".
+func IsValidCharmSeries(series string) bool {
+	if series == "" || !('a' <= series[0] && series[0] <= 'z') {
+		return false
+	}
+	for i := 0; i < len(series); i++ {
+		if c := series[i]; !('a' <= c && c <= 'z' || '0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Schema returns the schema of the URL, such as CharmStoreSchema.
+func (u CharmURL) Schema() string { return u.schema }
+
+// User returns the user that owns the charm, or the empty string if
+// the URL does not name one.
+func (u CharmURL) User() string { return u.user }
+
+// Series returns the series of the charm, or the empty string if the
+// URL does not name one.
+func (u CharmURL) Series() string { return u.series }
+
+// Name returns the name of the charm.
+func (u CharmURL) Name() string { return u.name }
+
+// Revision returns the revision of the charm, or -1 if the URL does
+// not specify one.
+func (u CharmURL) Revision() int { return u.revision }
+
+// WithRevision returns the URL with its revision replaced. A revision
+// of -1 removes the revision.
+func (u CharmURL) WithRevision(revision int) CharmURL {
+	if revision < -1 {
+		panic(fmt.Sprintf("invalid charm revision %d", revision))
+	}
+	u.revision = revision
+	return u
+}
+
+// String returns the string form of the URL.
+func (u CharmURL) String() string {
+	var b strings.Builder
+	b.WriteString(u.schema + ":")
+	if u.user != "" {
+		b.WriteString("~" + u.user + "/")
+	}
+	if u.series != "" {
+		b.WriteString(u.series + "/")
+	}
+	b.WriteString(u.name)
+	if u.revision >= 0 {
+		b.WriteString("-" + strconv.Itoa(u.revision))
+	}
+	return b.String()
+}
+
+// CharmTag represents a charm, identified by its URL.
+type CharmTag struct {
+	url string
+}
+
+func (t CharmTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t CharmTag) Kind() string   { return CharmTagKind }
+func (t CharmTag) Id() string     { return t.url }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t CharmTag) AppendString(dst []byte) []byte {
+	return append(append(dst, CharmTagKind+KindSeparator...), t.url...)
+}
+
+// URL returns the parsed URL of the charm.
+func (t CharmTag) URL() (CharmURL, error) {
+	return ParseCharmURL(t.url)
+}
+
+// NewCharmTag returns the tag of the charm with the given URL. It will
+// panic if the given URL is not valid.
+func NewCharmTag(url string) CharmTag {
+	if !IsValidCharmURL(url) {
+		panic(fmt.Sprintf("%q is not a valid charm URL", url))
+	}
+	return CharmTag{url: url}
+}
+
+// ParseCharmTag parses a charm tag string.
+func ParseCharmTag(charmTag string) (CharmTag, error) {
+	tag, err := ParseTag(charmTag)
+	if err != nil {
+		return CharmTag{}, err
+	}
+	ct, ok := tag.(CharmTag)
+	if !ok {
+		return CharmTag{}, invalidTagError(charmTag, CharmTagKind)
+	}
+	return ct, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type charmSuite struct{}
+
+var _ = gc.Suite(&charmSuite{})
+
+var parseCharmURLTests = []struct {
+	url      string
+	schema   string
+	user     string
+	series   string
+	name     string
+	revision int
+	err      string
+}{{
+	url:      "cs:~bob/trusty/wordpress-3",
+	schema:   "cs",
+	user:     "bob",
+	series:   "trusty",
+	name:     "wordpress",
+	revision: 3,
+}, {
+	url:      "cs:trusty/mysql",
+	schema:   "cs",
+	series:   "trusty",
+	name:     "mysql",
+	revision: -1,
+}, {
+	url:      "cs:mysql-0",
+	schema:   "cs",
+	name:     "mysql",
+	revision: 0,
+}, {
+	url:      "cs:~bob/hosted-mysql-2",
+	schema:   "cs",
+	user:     "bob",
+	name:     "hosted-mysql",
+	revision: 2,
+}, {
+	url:      "local:win2012r2/my-charm42",
+	schema:   "local",
+	series:   "win2012r2",
+	name:     "my-charm42",
+	revision: -1,
+}, {
+	url: "wordpress",
+	err: `"wordpress" is not a valid charm URL: no schema`,
+}, {
+	url: "http:wordpress",
+	err: `"http:wordpress" is not a valid charm URL: unknown schema "http"`,
+}, {
+	url: "local:~bob/wordpress",
+	err: `"local:~bob/wordpress" is not a valid charm URL: user not allowed in local URL`,
+}, {
+	url: "cs:~/wordpress",
+	err: `"cs:~/wordpress" is not a valid charm URL: invalid user ""`,
+}, {
+	url: "cs:Trusty/wordpress",
+	err: `"cs:Trusty/wordpress" is not a valid charm URL: invalid series "Trusty"`,
+}, {
+	url: "cs:1trusty/wordpress",
+	err: `"cs:1trusty/wordpress" is not a valid charm URL: invalid series "1trusty"`,
+}, {
+	url: "cs:~bob/trusty/wordpress/1",
+	err: `"cs:~bob/trusty/wordpress/1" is not a valid charm URL: too many path segments`,
+}, {
+	url: "cs:trusty/WordPress",
+	err: `"cs:trusty/WordPress" is not a valid charm URL: invalid name "WordPress"`,
+}, {
+	url: "cs:trusty/",
+	err: `"cs:trusty/" is not a valid charm URL: invalid name ""`,
+}, {
+	url: "cs:wordpress-",
+	err: `"cs:wordpress-" is not a valid charm URL: invalid name "wordpress-"`,
+}, {
+	url: "cs:wordpress-01",
+	err: `"cs:wordpress-01" is not a valid charm URL: invalid name "wordpress-01"`,
+}, {
+	url: "cs:wordpress-99999999999999999999",
+	err: `"cs:wordpress-99999999999999999999" is not a valid charm URL: invalid revision "99999999999999999999"`,
+}}
+
+func (s *charmSuite) TestParseCharmURL(c *gc.C) {
+	for i, test := range parseCharmURLTests {
+		c.Logf("test %d: %q", i, test.url)
+		u, err := names.ParseCharmURL(test.url)
+		c.Check(names.IsValidCharmURL(test.url), gc.Equals, test.err == "")
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			c.Check(func() { names.NewCharmTag(test.url) }, gc.PanicMatches, `".*" is not a valid charm URL`)
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+		c.Check(u.Schema(), gc.Equals, test.schema)
+		c.Check(u.User(), gc.Equals, test.user)
+		c.Check(u.Series(), gc.Equals, test.series)
+		c.Check(u.Name(), gc.Equals, test.name)
+		c.Check(u.Revision(), gc.Equals, test.revision)
+		c.Check(u.String(), gc.Equals, test.url)
+
+		tag := names.NewCharmTag(test.url)
+		c.Check(tag.String(), gc.Equals, "charm-"+test.url)
+		tagURL, err := tag.URL()
+		c.Check(err, gc.IsNil)
+		c.Check(tagURL, gc.Equals, u)
+	}
+}
+
+func (s *charmSuite) TestWithRevision(c *gc.C) {
+	u, err := names.ParseCharmURL("cs:trusty/mysql")
+	c.Assert(err, gc.IsNil)
+	c.Check(u.WithRevision(4).String(), gc.Equals, "cs:trusty/mysql-4")
+	c.Check(u.WithRevision(4).WithRevision(-1), gc.Equals, u)
+	c.Check(u.Revision(), gc.Equals, -1)
+	c.Check(func() { u.WithRevision(-2) }, gc.PanicMatches, `invalid charm revision -2`)
+}
+
+var parseCharmTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "charm-cs:~bob/trusty/wordpress-3",
+	expected: names.NewCharmTag("cs:~bob/trusty/wordpress-3"),
+}, {
+	tag: "charm-wordpress",
+	err: names.InvalidTagError("charm-wordpress", names.CharmTagKind),
+}, {
+	tag: "charm",
+	err: names.InvalidTagError("charm", ""),
+}, {
+	tag: "service-wordpress",
+	err: names.InvalidTagError("service-wordpress", names.CharmTagKind),
+}}
+
+func (s *charmSuite) TestParseCharmTag(c *gc.C) {
+	for i, t := range parseCharmTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseCharmTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const CloudTagKind = "cloud"
+
+// CloudSnippet matches a cloud name: letters and digits, optionally
+// separated by single hyphens, such as "aws" or "us-east-1".
+const CloudSnippet = "(?:[a-zA-Z0-9]+(?:-[a-zA-Z0-9]+)*)"
+
+// IsValidCloud reports whether name is a valid cloud name.
+func IsValidCloud(name string) bool {
+	return matchCloud(name)
+}
+
+type CloudTag struct {
+	name string
+}
+
+func (t CloudTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t CloudTag) Kind() string   { return CloudTagKind }
+func (t CloudTag) Id() string     { return t.name }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t CloudTag) AppendString(dst []byte) []byte {
+	return append(append(dst, CloudTagKind+KindSeparator...), t.name...)
+}
+
+// NewCloudTag returns the tag of a cloud with the given name.
+func NewCloudTag(name string) CloudTag {
+	if !IsValidCloud(name) {
+		panic(fmt.Sprintf("%q is not a valid cloud name", name))
+	}
+	return CloudTag{name: name}
+}
+
+// ParseCloudTag parses a cloud tag string.
+func ParseCloudTag(cloudTag string) (CloudTag, error) {
+	tag, err := ParseTag(cloudTag)
+	if err != nil {
+		return CloudTag{}, err
+	}
+	ct, ok := tag.(CloudTag)
+	if !ok {
+		return CloudTag{}, invalidTagError(cloudTag, CloudTagKind)
+	}
+	return ct, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type cloudSuite struct{}
+
+var _ = gc.Suite(&cloudSuite{})
+
+var cloudNameTests = []struct {
+	pattern string
+	valid   bool
+}{
+	{pattern: "", valid: false},
+	{pattern: "aws", valid: true},
+	{pattern: "AWS", valid: true},
+	{pattern: "aws-china", valid: true},
+	{pattern: "openstack2", valid: true},
+	{pattern: "42", valid: true},
+	{pattern: "-aws", valid: false},
+	{pattern: "aws-", valid: false},
+	{pattern: "aws--china", valid: false},
+	{pattern: "aws_china", valid: false},
+	{pattern: "aws.china", valid: false},
+	{pattern: "aws/china", valid: false},
+}
+
+func (s *cloudSuite) TestCloudNames(c *gc.C) {
+	for i, test := range cloudNameTests {
+		c.Logf("test %d: %q", i, test.pattern)
+		c.Check(names.IsValidCloud(test.pattern), gc.Equals, test.valid)
+		if test.valid {
+			expectTag := fmt.Sprintf("%s-%s", names.CloudTagKind, test.pattern)
+			c.Check(names.NewCloudTag(test.pattern).String(), gc.Equals, expectTag)
+		} else {
+			expectErr := fmt.Sprintf("%q is not a valid cloud name", test.pattern)
+			testCloudTag := func() { names.NewCloudTag(test.pattern) }
+			c.Check(testCloudTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+		}
+	}
+}
+
+var parseCloudTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "cloud-aws",
+	expected: names.NewCloudTag("aws"),
+}, {
+	tag:      "cloud-aws-china",
+	expected: names.NewCloudTag("aws-china"),
+}, {
+	tag: "cloud-aws/china",
+	err: names.InvalidTagError("cloud-aws/china", names.CloudTagKind),
+}, {
+	tag: "cloud",
+	err: names.InvalidTagError("cloud", ""),
+}, {
+	tag: "space-aws",
+	err: names.InvalidTagError("space-aws", names.CloudTagKind),
+}}
+
+func (s *cloudSuite) TestParseCloudTag(c *gc.C) {
+	for i, t := range parseCloudTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseCloudTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const CloudCredentialTagKind = "cloudcred"
+
+// CloudCredentialNameSnippet matches the name of a cloud credential:
+// letters, digits, dots, hyphens and underscores, starting with a
+// letter or digit.
+const CloudCredentialNameSnippet = "(?:[a-zA-Z0-9][a-zA-Z0-9._-]*)"
+
+// cloudCredentialTagSeparator separates the cloud, owner and name in
+// the string representation of a cloud credential tag, where "/"
+// cannot be used and hyphens are ambiguous.
+const cloudCredentialTagSeparator = "_"
+
+// IsValidCloudCredentialName returns whether name is a valid cloud
+// credential name.
+func IsValidCloudCredentialName(name string) bool {
+	return matchCloudCredentialName(name)
+}
+
+// IsValidCloudCredential returns whether id is a valid cloud
+// credential id: the name of a cloud, the id of the owning user and
+// the credential name, separated by slashes, such as
+// "aws/bob@local/default".
+func IsValidCloudCredential(id string) bool {
+	_, ok := splitCloudCredentialId(id, "/")
+	return ok
+}
+
+// CloudCredentialTag represents a credential, owned by a user, used to
+// access a cloud.
+type CloudCredentialTag struct {
+	cloud CloudTag
+	owner UserTag
+	name  string
+}
+
+func (t CloudCredentialTag) String() string { return string(t.AppendString(nil)) }
+func (t CloudCredentialTag) Kind() string   { return CloudCredentialTagKind }
+func (t CloudCredentialTag) Id() string {
+	if t == (CloudCredentialTag{}) {
+		return ""
+	}
+	return t.cloud.Id() + "/" + t.owner.Id() + "/" + t.name
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t CloudCredentialTag) AppendString(dst []byte) []byte {
+	dst = append(dst, CloudCredentialTagKind+KindSeparator...)
+	if t == (CloudCredentialTag{}) {
+		return dst
+	}
+	dst = append(append(dst, t.cloud.name...), cloudCredentialTagSeparator...)
+	dst = append(append(dst, t.owner.Id()...), cloudCredentialTagSeparator...)
+	return append(dst, t.name...)
+}
+
+// Cloud returns the tag of the cloud the credential is used with.
+func (t CloudCredentialTag) Cloud() CloudTag { return t.cloud }
+
+// Owner returns the tag of the user that owns the credential.
+func (t CloudCredentialTag) Owner() UserTag { return t.owner }
+
+// Name returns the name of the credential, which is unique amongst the
+// owner's credentials for the cloud.
+func (t CloudCredentialTag) Name() string { return t.name }
+
+// NewCloudCredentialTag returns the tag of the cloud credential with
+// the given id, such as "aws/bob@local/default". It will panic if the
+// given id is not valid.
+func NewCloudCredentialTag(id string) CloudCredentialTag {
+	t, ok := splitCloudCredentialId(id, "/")
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid cloud credential id", id))
+	}
+	return t
+}
+
+// ParseCloudCredentialTag parses a cloud credential tag string.
+func ParseCloudCredentialTag(cloudCredentialTag string) (CloudCredentialTag, error) {
+	tag, err := ParseTag(cloudCredentialTag)
+	if err != nil {
+		return CloudCredentialTag{}, err
+	}
+	ct, ok := tag.(CloudCredentialTag)
+	if !ok {
+		return CloudCredentialTag{}, invalidTagError(cloudCredentialTag, CloudCredentialTagKind)
+	}
+	return ct, nil
+}
+
+// splitCloudCredentialId splits a cloud credential id, or the tag
+// suffix of one, into its segments, validating each of them.
+func splitCloudCredentialId(id, sep string) (CloudCredentialTag, bool) {
+	parts := strings.SplitN(id, sep, 3)
+	if len(parts) != 3 || !IsValidCloud(parts[0]) || !IsValidCloudCredentialName(parts[2]) {
+		return CloudCredentialTag{}, false
+	}
+	name, provider, ok := matchUser(parts[1])
+	if !ok {
+		return CloudCredentialTag{}, false
+	}
+	return CloudCredentialTag{
+		cloud: CloudTag{name: parts[0]},
+		owner: UserTag{name: name, provider: provider},
+		name:  parts[2],
+	}, true
+}
@@ -0,0 +1,133 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type cloudCredentialSuite struct{}
+
+var _ = gc.Suite(&cloudCredentialSuite{})
+
+var cloudCredentialTests = []struct {
+	id    string
+	valid bool
+	tag   string
+	cloud string
+	owner string
+	name  string
+}{{
+	id:    "aws/bob@local/default",
+	valid: true,
+	tag:   "cloudcred-aws_bob@local_default",
+	cloud: "aws",
+	owner: "bob@local",
+	name:  "default",
+}, {
+	id:    "aws-china/bob/my_cred.2",
+	valid: true,
+	tag:   "cloudcred-aws-china_bob_my_cred.2",
+	cloud: "aws-china",
+	owner: "bob",
+	name:  "my_cred.2",
+}, {
+	id:    "azure/jo.smith@ldap/prod-1",
+	valid: true,
+	tag:   "cloudcred-azure_jo.smith@ldap_prod-1",
+	cloud: "azure",
+	owner: "jo.smith@ldap",
+	name:  "prod-1",
+}, {
+	id: "",
+}, {
+	id: "aws",
+}, {
+	id: "aws/bob",
+}, {
+	id: "aws/bob/",
+}, {
+	id: "aws//default",
+}, {
+	id: "/bob/default",
+}, {
+	id: "aws_china/bob/default",
+}, {
+	id: "aws/b^b/default",
+}, {
+	id: "aws/bob/_default",
+}, {
+	id: "aws/bob/default/1",
+}}
+
+func (s *cloudCredentialSuite) TestCloudCredentialTag(c *gc.C) {
+	for i, test := range cloudCredentialTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidCloudCredential(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid cloud credential id", test.id)
+			testTag := func() { names.NewCloudCredentialTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewCloudCredentialTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Cloud(), gc.Equals, names.NewCloudTag(test.cloud))
+		c.Check(tag.Owner(), gc.Equals, names.NewUserTag(test.owner))
+		c.Check(tag.Name(), gc.Equals, test.name)
+
+		parsed, err := names.ParseCloudCredentialTag(test.tag)
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+func (s *cloudCredentialSuite) TestZeroValue(c *gc.C) {
+	var tag names.CloudCredentialTag
+	c.Check(tag.Id(), gc.Equals, "")
+	c.Check(tag.String(), gc.Equals, "cloudcred-")
+}
+
+var parseCloudCredentialTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "cloudcred-aws_bob_default",
+	expected: names.NewCloudCredentialTag("aws/bob/default"),
+}, {
+	tag: "cloudcred-aws/bob/default",
+	err: names.InvalidTagError("cloudcred-aws/bob/default", names.CloudCredentialTagKind),
+}, {
+	tag: "cloudcred-aws_bob",
+	err: names.InvalidTagError("cloudcred-aws_bob", names.CloudCredentialTagKind),
+}, {
+	tag: "cloudcred",
+	err: names.InvalidTagError("cloudcred", ""),
+}, {
+	tag: "cloud-aws",
+	err: names.InvalidTagError("cloud-aws", names.CloudCredentialTagKind),
+}}
+
+func (s *cloudCredentialSuite) TestParseCloudCredentialTag(c *gc.C) {
+	for i, t := range parseCloudCredentialTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseCloudCredentialTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
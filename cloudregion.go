@@ -0,0 +1,104 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const CloudRegionTagKind = "cloudregion"
+
+// CloudRegionNameSnippet matches the name of a cloud region. Region
+// names follow the same rules as availability zone names, such as
+// "us-east-1" or "RegionOne".
+const CloudRegionNameSnippet = AvailabilityZoneSnippet
+
+// cloudRegionTagSeparator separates the cloud and region in the string
+// representation of a cloud region tag. Cloud names never contain it.
+const cloudRegionTagSeparator = "_"
+
+// IsValidCloudRegionName returns whether name is a valid cloud region
+// name.
+func IsValidCloudRegionName(name string) bool {
+	return matchAvailabilityZone(name)
+}
+
+// IsValidCloudRegion returns whether id is a valid cloud region id:
+// the name of a cloud and the name of one of its regions, separated by
+// a slash, such as "aws/us-east-1".
+func IsValidCloudRegion(id string) bool {
+	_, ok := splitCloudRegionId(id, "/")
+	return ok
+}
+
+// CloudRegionTag represents a region of a cloud.
+type CloudRegionTag struct {
+	cloud  CloudTag
+	region string
+}
+
+func (t CloudRegionTag) String() string { return string(t.AppendString(nil)) }
+func (t CloudRegionTag) Kind() string   { return CloudRegionTagKind }
+func (t CloudRegionTag) Id() string {
+	if t == (CloudRegionTag{}) {
+		return ""
+	}
+	return t.cloud.Id() + "/" + t.region
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t CloudRegionTag) AppendString(dst []byte) []byte {
+	dst = append(dst, CloudRegionTagKind+KindSeparator...)
+	if t == (CloudRegionTag{}) {
+		return dst
+	}
+	dst = append(append(dst, t.cloud.name...), cloudRegionTagSeparator...)
+	return append(dst, t.region...)
+}
+
+// Cloud returns the tag of the cloud the region belongs to.
+func (t CloudRegionTag) Cloud() CloudTag { return t.cloud }
+
+// Region returns the name of the region, which is unique amongst the
+// regions of its cloud.
+func (t CloudRegionTag) Region() string { return t.region }
+
+// NewCloudRegionTag returns the tag of the cloud region with the given
+// id, such as "aws/us-east-1". It will panic if the given id is not
+// valid.
+func NewCloudRegionTag(id string) CloudRegionTag {
+	t, ok := splitCloudRegionId(id, "/")
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid cloud region id", id))
+	}
+	return t
+}
+
+// ParseCloudRegionTag parses a cloud region tag string.
+func ParseCloudRegionTag(cloudRegionTag string) (CloudRegionTag, error) {
+	tag, err := ParseTag(cloudRegionTag)
+	if err != nil {
+		return CloudRegionTag{}, err
+	}
+	rt, ok := tag.(CloudRegionTag)
+	if !ok {
+		return CloudRegionTag{}, invalidTagError(cloudRegionTag, CloudRegionTagKind)
+	}
+	return rt, nil
+}
+
+// splitCloudRegionId splits a cloud region id, or the tag suffix of
+// one, into its segments, validating each of them.
+func splitCloudRegionId(id, sep string) (CloudRegionTag, bool) {
+	parts := strings.SplitN(id, sep, 2)
+	if len(parts) != 2 || !IsValidCloud(parts[0]) || !IsValidCloudRegionName(parts[1]) {
+		return CloudRegionTag{}, false
+	}
+	return CloudRegionTag{
+		cloud:  CloudTag{name: parts[0]},
+		region: parts[1],
+	}, true
+}
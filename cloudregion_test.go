@@ -0,0 +1,91 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type cloudRegionSuite struct{}
+
+var _ = gc.Suite(&cloudRegionSuite{})
+
+var cloudRegionIdTests = []struct {
+	id     string
+	valid  bool
+	cloud  string
+	region string
+	tag    string
+}{
+	{id: "aws/us-east-1", valid: true, cloud: "aws", region: "us-east-1", tag: "cloudregion-aws_us-east-1"},
+	{id: "openstack-1/RegionOne", valid: true, cloud: "openstack-1", region: "RegionOne", tag: "cloudregion-openstack-1_RegionOne"},
+	{id: "maas/region_1.a", valid: true, cloud: "maas", region: "region_1.a", tag: "cloudregion-maas_region_1.a"},
+	{id: ""},
+	{id: "aws"},
+	{id: "aws/"},
+	{id: "/us-east-1"},
+	{id: "aws_1/us-east-1"},
+	{id: "aws/us-east-1/a"},
+	{id: "aws/us--east-1"},
+}
+
+func (s *cloudRegionSuite) TestCloudRegionTag(c *gc.C) {
+	for i, test := range cloudRegionIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidCloudRegion(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid cloud region id", test.id)
+			testTag := func() { names.NewCloudRegionTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewCloudRegionTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Cloud(), gc.Equals, names.NewCloudTag(test.cloud))
+		c.Check(tag.Region(), gc.Equals, test.region)
+		parsed, err := names.ParseCloudRegionTag(test.tag)
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseCloudRegionTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "cloudregion-aws_us-east-1",
+	expected: names.NewCloudRegionTag("aws/us-east-1"),
+}, {
+	tag: "cloudregion-aws/us-east-1",
+	err: names.InvalidTagError("cloudregion-aws/us-east-1", names.CloudRegionTagKind),
+}, {
+	tag: "cloudregion-aws",
+	err: names.InvalidTagError("cloudregion-aws", names.CloudRegionTagKind),
+}, {
+	tag: "cloud-aws",
+	err: names.InvalidTagError("cloud-aws", names.CloudRegionTagKind),
+}}
+
+func (s *cloudRegionSuite) TestParseCloudRegionTag(c *gc.C) {
+	for i, t := range parseCloudRegionTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseCloudRegionTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
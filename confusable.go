@@ -0,0 +1,130 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// confusables maps characters to their prototypes in the confusables
+// table of Unicode Technical Standard #39. Only entries whose
+// prototype is a single ASCII character are included; sequence
+// prototypes such as "rn" for "m" would make ordinary names like
+// "modem" and "modern" collide. Lower case Cyrillic and Greek letters
+// are included so that their upper case forms match once folded.
+// Fullwidth forms are handled separately.
+var confusables = map[rune]rune{
+	'0': 'O',
+	'1': 'l',
+	'I': 'l',
+	'|': 'l',
+
+	// Cyrillic.
+	'а': 'a', 'е': 'e', 'һ': 'h', 'і': 'i', 'ј': 'j', 'о': 'o',
+	'р': 'p', 'с': 'c', 'ѕ': 's', 'у': 'y', 'х': 'x', 'ԁ': 'd',
+	'ԛ': 'q', 'ԝ': 'w', 'І': 'l',
+
+	// Greek.
+	'α': 'a', 'ι': 'i', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'Ι': 'l',
+}
+
+// UserSkeleton returns a normalised form of a user or model name such
+// that names which are visually confusable, for instance "lan",
+// "Ian" (with an upper case "I") and "lаn" (with a Cyrillic "а"),
+// share the same skeleton. It is the skeleton of Unicode Technical
+// Standard #39, restricted to the characters in its confusables table
+// that resemble a single ASCII character, followed by case folding.
+func UserSkeleton(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '！' && r <= '～' {
+			// Fullwidth forms of the printable ASCII characters.
+			r = r - '！' + '!'
+		}
+		if c, ok := confusables[r]; ok {
+			r = c
+		}
+		r = unicode.ToLower(r)
+		if c, ok := confusables[r]; ok {
+			r = c
+		}
+		return r
+	}, name)
+}
+
+// nameSkeletons returns the skeletons under which name is compared
+// with existing names: its own skeleton, in which "I" and "1" read as
+// "l", and that of its lower case form with "1" read as "i", so that
+// "adm1n" and "ADMIN" are both confusable with "admin".
+func nameSkeletons(name string) [2]string {
+	lower := strings.Map(func(r rune) rune {
+		if r == '1' {
+			return 'i'
+		}
+		return unicode.ToLower(r)
+	}, name)
+	return [2]string{UserSkeleton(name), UserSkeleton(lower)}
+}
+
+// IsMixedScript returns whether name contains letters from more than
+// one script, for instance a Latin "admin" spelt with a Cyrillic "а".
+// Characters common to all scripts, such as digits and punctuation,
+// are ignored. User and model names are restricted to ASCII, so this
+// is for checking other names shown to users, such as display names.
+func IsMixedScript(name string) bool {
+	var first *unicode.RangeTable
+	for _, r := range name {
+		script := runeScript(r)
+		if script == nil {
+			continue
+		}
+		if first == nil {
+			first = script
+		} else if script != first {
+			return true
+		}
+	}
+	return false
+}
+
+// runeScript returns the script of r, or nil if r is not a letter or
+// belongs to no particular script.
+func runeScript(r rune) *unicode.RangeTable {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' {
+			return unicode.Latin
+		}
+		return nil
+	}
+	if !unicode.IsLetter(r) {
+		return nil
+	}
+	for name, table := range unicode.Scripts {
+		if name != "Common" && name != "Inherited" && unicode.Is(table, r) {
+			return table
+		}
+	}
+	return nil
+}
+
+// isConfusableName returns whether name is visually confusable with,
+// but not identical to, any of the existing names.
+func isConfusableName(name string, existing []string) bool {
+	if len(existing) == 0 {
+		return false
+	}
+	skeletons := nameSkeletons(name)
+	for _, other := range existing {
+		if other == name {
+			continue
+		}
+		for _, o := range nameSkeletons(other) {
+			if o == skeletons[0] || o == skeletons[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
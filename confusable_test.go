@@ -0,0 +1,113 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type confusableSuite struct{}
+
+var _ = gc.Suite(&confusableSuite{})
+
+func (s *confusableSuite) TestUserSkeleton(c *gc.C) {
+	for i, t := range []struct {
+		a, b       string
+		confusable bool
+	}{
+		{"admin", "admin", true},
+		{"admin", "Admin", true},
+		{"admin", "adm1n", false},
+		{"admln", "adm1n", true},
+		{"lan", "Ian", true},
+		{"lan", "ІАN", true}, // Cyrillic I and A
+		{"admin", "aclmin", false},
+		{"admin", "аdmin", true}, // Cyrillic a
+		{"bob", "bοb", true},     // Greek omicron
+		{"bob", "b0b", true},
+		{"bob", "ｂｏｂ", true}, // fullwidth
+		{"modern", "modem", false},
+		{"willow", "vvillow", false},
+		{"bill", "bi11", true},
+		{"bob", "rob", false},
+		{"alice", "alicia", false},
+	} {
+		c.Logf("test %d: %q %q", i, t.a, t.b)
+		c.Check(names.UserSkeleton(t.a) == names.UserSkeleton(t.b), gc.Equals, t.confusable)
+	}
+}
+
+func (s *confusableSuite) TestIsMixedScript(c *gc.C) {
+	for i, t := range []struct {
+		name  string
+		mixed bool
+	}{
+		{"", false},
+		{"admin", false},
+		{"admin-2.0", false},
+		{"ａｄｍｉｎ", false}, // fullwidth Latin
+		{"аdmin", true},  // Cyrillic a
+		{"bοb", true},    // Greek omicron
+		{"админ", false},
+		{"ορθο", false},
+		{"админ1", false},
+	} {
+		c.Logf("test %d: %q", i, t.name)
+		c.Check(names.IsMixedScript(t.name), gc.Equals, t.mixed)
+	}
+}
+
+func (s *confusableSuite) TestUserValidatorExisting(c *gc.C) {
+	v := names.UserValidator{Existing: []string{"admin", "bob@example.com"}}
+	c.Check(v.IsValidUser("admin"), gc.Equals, true)
+	c.Check(v.IsValidUser("alice"), gc.Equals, true)
+	c.Check(v.IsValidUser("Admin"), gc.Equals, false)
+	c.Check(v.IsValidUser("adm1n"), gc.Equals, false)
+	c.Check(v.IsValidUser("ADMIN"), gc.Equals, false)
+	c.Check(v.IsValidUser("admln"), gc.Equals, true)
+	c.Check(v.IsValidUser("b0b@example.com"), gc.Equals, false)
+	c.Check(v.IsValidUser("b^b"), gc.Equals, false)
+
+	v.AllowEmail = true
+	c.Check(v.IsValidUser("alice+ops@example.com"), gc.Equals, true)
+	c.Check(v.IsValidUser("b0b@examp1e.com"), gc.Equals, false)
+
+	v = names.UserValidator{Existing: []string{"modern"}}
+	c.Check(v.IsValidUser("modem"), gc.Equals, true)
+}
+
+func (s *confusableSuite) TestUserValidatorASCIIHomoglyphs(c *gc.C) {
+	v := names.UserValidator{Existing: []string{"lan", "admin"}}
+	for i, t := range []struct {
+		id    string
+		valid bool
+	}{
+		{"lan", true},
+		{"admin", true},
+		{"Ian", false},
+		{"IAN", false},
+		{"1an", false},
+		{"adm1n", false},
+		{"admIn", false},
+		{"ian", true},
+		{"bill", true},
+	} {
+		c.Logf("test %d: %q", i, t.id)
+		c.Check(v.IsValidUser(t.id), gc.Equals, t.valid)
+	}
+}
+
+func (s *confusableSuite) TestModelNameValidator(c *gc.C) {
+	c.Check(names.IsValidModelName("prod-1"), gc.Equals, true)
+	c.Check(names.IsValidModelName("Prod"), gc.Equals, false)
+
+	v := names.ModelNameValidator{Existing: []string{"prod", "staging"}}
+	c.Check(v.IsValidModelName("prod"), gc.Equals, true)
+	c.Check(v.IsValidModelName("pr0d"), gc.Equals, false)
+	c.Check(v.IsValidModelName("stag1ng"), gc.Equals, false)
+	c.Check(v.IsValidModelName("product"), gc.Equals, true)
+	c.Check(v.IsValidModelName("-prod"), gc.Equals, false)
+}
@@ -0,0 +1,26 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+// TagConstraint is satisfied by each of the concrete tag types, so
+// generic code can be written for any kind of tag without losing its
+// type to the Tag interface:
+//
+//	func Ids[T names.TagConstraint](tags []T) []string
+//
+// New tag types are added to the constraint as they are introduced.
+type TagConstraint interface {
+	UnitTag | MachineTag | ServiceTag | EnvironTag | UserTag |
+		RelationTag | NetworkTag | ActionTag | ActionResultTag |
+		VolumeTag | FilesystemTag | StorageTag | StorageAttachmentTag |
+		VolumeAttachmentTag | FilesystemAttachmentTag | SpaceTag |
+		SubnetTag | IPAddressTag | ModelTag | ControllerTag |
+		ControllerAgentTag | CloudTag | CloudCredentialTag | ApplicationTag |
+		ApplicationOfferTag | RemoteApplicationTag | RemoteRelationTag |
+		OperationTag | SecretTag | SecretBackendTag | CharmTag | ResourceTag |
+		MetricBatchTag | InstanceTag | AvailabilityZoneTag | CloudRegionTag |
+		ImageTag | SSHKeyTag | CertificateTag | BackupTag | UpgradeTag |
+		MigrationTag | LeaseTag | AuditEntryTag | TokenTag | AgentTag
+	Tag
+}
@@ -0,0 +1,37 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type constraintSuite struct{}
+
+var _ = gc.Suite(&constraintSuite{})
+
+func ids[T names.TagConstraint](tags []T) []string {
+	ids := make([]string, len(tags))
+	for i, t := range tags {
+		ids[i] = t.Id()
+	}
+	return ids
+}
+
+func first[T names.TagConstraint](tags []T) T {
+	return tags[0]
+}
+
+func (s *constraintSuite) TestTagConstraint(c *gc.C) {
+	units := []names.UnitTag{names.NewUnitTag("mysql/0"), names.NewUnitTag("mysql/1")}
+	c.Check(ids(units), gc.DeepEquals, []string{"mysql/0", "mysql/1"})
+	c.Check(first(units), gc.Equals, units[0])
+
+	// The concrete type is preserved, so its methods remain available.
+	actions := []names.ActionTag{names.NewActionTag("wordpress/0" + names.ActionMarker + "3")}
+	c.Check(ids(actions), gc.DeepEquals, []string{"wordpress/0_a_3"})
+	c.Check(first(actions).Sequence(), gc.Equals, 3)
+}
@@ -0,0 +1,54 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const ControllerTagKind = "controller"
+
+// ControllerTag represents a controller, which manages environments
+// (or models) and is identified by its own UUID.
+type ControllerTag struct {
+	uuid string
+}
+
+// NewControllerTag returns the tag of a controller with the given
+// controller UUID. It will panic if the given UUID is not valid.
+func NewControllerTag(uuid string) ControllerTag {
+	if !IsValidController(uuid) {
+		panic(fmt.Sprintf("%q is not a valid controller UUID", uuid))
+	}
+	return ControllerTag{uuid: uuid}
+}
+
+// ParseControllerTag parses a controller tag string.
+func ParseControllerTag(controllerTag string) (ControllerTag, error) {
+	tag, err := ParseTag(controllerTag)
+	if err != nil {
+		return ControllerTag{}, err
+	}
+	ct, ok := tag.(ControllerTag)
+	if !ok {
+		return ControllerTag{}, invalidTagError(controllerTag, ControllerTagKind)
+	}
+	return ct, nil
+}
+
+func (t ControllerTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ControllerTag) Kind() string   { return ControllerTagKind }
+func (t ControllerTag) Id() string     { return t.uuid }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ControllerTag) AppendString(dst []byte) []byte {
+	return append(append(dst, ControllerTagKind+KindSeparator...), t.uuid...)
+}
+
+// IsValidController returns whether id is a valid controller UUID.
+func IsValidController(id string) bool {
+	_, ok := parseUUID(id)
+	return ok
+}
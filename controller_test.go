@@ -0,0 +1,56 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type controllerSuite struct{}
+
+var _ = gc.Suite(&controllerSuite{})
+
+var parseControllerTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "controller-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewControllerTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "controller-f47ac10b",
+	err: names.InvalidTagError("controller-f47ac10b", names.ControllerTagKind),
+}, {
+	tag: "dave",
+	err: names.InvalidTagError("dave", ""),
+}, {
+	tag: "environment-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	err: names.InvalidTagError("environment-f47ac10b-58cc-4372-a567-0e02b2c3d479", names.ControllerTagKind),
+}}
+
+func (s *controllerSuite) TestParseControllerTag(c *gc.C) {
+	for i, t := range parseControllerTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseControllerTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+func (s *controllerSuite) TestIsValidController(c *gc.C) {
+	c.Check(names.IsValidController("f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, true)
+	c.Check(names.IsValidController("controller"), gc.Equals, false)
+	c.Check(names.IsValidController(""), gc.Equals, false)
+	c.Check(names.IsValidController("x"+"f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, false)
+	c.Check(func() { names.NewControllerTag("") }, gc.PanicMatches, `"" is not a valid controller UUID`)
+}
@@ -0,0 +1,55 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const ControllerAgentTagKind = "controlleragent"
+
+// ControllerAgentTag represents an agent running controller processes
+// that are not tied to a machine. Its id is a number, as a machine's
+// is, but it is a distinct kind of entity.
+type ControllerAgentTag struct {
+	id string
+}
+
+func (t ControllerAgentTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ControllerAgentTag) Kind() string   { return ControllerAgentTagKind }
+func (t ControllerAgentTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ControllerAgentTag) AppendString(dst []byte) []byte {
+	return append(append(dst, ControllerAgentTagKind+KindSeparator...), t.id...)
+}
+
+// NewControllerAgentTag returns the tag of the controller agent with
+// the given id. It will panic if the given id is not valid.
+func NewControllerAgentTag(id string) ControllerAgentTag {
+	if !IsValidControllerAgent(id) {
+		panic(fmt.Sprintf("%q is not a valid controller agent id", id))
+	}
+	return ControllerAgentTag{id: id}
+}
+
+// ParseControllerAgentTag parses a controller agent tag string.
+func ParseControllerAgentTag(controllerAgentTag string) (ControllerAgentTag, error) {
+	tag, err := ParseTag(controllerAgentTag)
+	if err != nil {
+		return ControllerAgentTag{}, err
+	}
+	ct, ok := tag.(ControllerAgentTag)
+	if !ok {
+		return ControllerAgentTag{}, invalidTagError(controllerAgentTag, ControllerAgentTagKind)
+	}
+	return ct, nil
+}
+
+// IsValidControllerAgent returns whether id is a valid controller
+// agent id: a number without leading zeros, such as "0" or "12".
+func IsValidControllerAgent(id string) bool {
+	return matchControllerAgent(id)
+}
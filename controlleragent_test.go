@@ -0,0 +1,83 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type controllerAgentSuite struct{}
+
+var _ = gc.Suite(&controllerAgentSuite{})
+
+var controllerAgentIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "0", valid: true},
+	{id: "12", valid: true},
+	{id: ""},
+	{id: "01"},
+	{id: "-1"},
+	{id: "0/lxc/1"},
+	{id: "controller"},
+}
+
+func (s *controllerAgentSuite) TestControllerAgentTag(c *gc.C) {
+	for i, test := range controllerAgentIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidControllerAgent(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			c.Check(func() { names.NewControllerAgentTag(test.id) }, gc.PanicMatches, `".*" is not a valid controller agent id`)
+			continue
+		}
+		tag := names.NewControllerAgentTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, "controlleragent-"+test.id)
+	}
+}
+
+var parseControllerAgentTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "controlleragent-0",
+	expected: names.NewControllerAgentTag("0"),
+}, {
+	tag: "controlleragent-01",
+	err: names.InvalidTagError("controlleragent-01", names.ControllerAgentTagKind),
+}, {
+	tag: "controlleragent-0-lxc-1",
+	err: names.InvalidTagError("controlleragent-0-lxc-1", names.ControllerAgentTagKind),
+}, {
+	tag: "machine-0",
+	err: names.InvalidTagError("machine-0", names.ControllerAgentTagKind),
+}}
+
+func (s *controllerAgentSuite) TestParseControllerAgentTag(c *gc.C) {
+	for i, t := range parseControllerAgentTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseControllerAgentTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+func (s *controllerAgentSuite) TestControllerAgentIsAgent(c *gc.C) {
+	tag, err := names.ParseAgentTag("controlleragent-1")
+	c.Assert(err, gc.IsNil)
+	c.Check(tag.Entity(), gc.Equals, names.NewControllerAgentTag("1"))
+	c.Check(names.IsControllerAgent(tag), gc.Equals, true)
+	c.Check(names.IsMachineAgent(tag), gc.Equals, false)
+}
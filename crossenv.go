@@ -0,0 +1,68 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EnvironRef refers to a service or unit in another environment, as
+// written "admin/prod.mysql" or "admin/prod.mysql/0": the environment
+// owner and name qualify the local name of the entity.
+type EnvironRef struct {
+	owner   UserTag
+	environ string
+	entity  Tag
+}
+
+// NewEnvironRef returns a reference to the service or unit with the
+// given tag in the named environment owned by owner.
+func NewEnvironRef(owner UserTag, environName string, entity Tag) (EnvironRef, error) {
+	switch entity.(type) {
+	case ServiceTag, UnitTag:
+	default:
+		return EnvironRef{}, fmt.Errorf("cannot refer to %q in another environment", entity)
+	}
+	if !matchEnvironName(environName) {
+		return EnvironRef{}, fmt.Errorf("%q is not a valid environment name", environName)
+	}
+	return EnvironRef{owner: owner, environ: environName, entity: entity}, nil
+}
+
+// ParseEnvironRef parses a reference to a service or unit in another
+// environment, such as "admin/prod.mysql" or "bob@local/prod.mysql/0".
+func ParseEnvironRef(s string) (EnvironRef, error) {
+	// Neither user ids nor environment names contain "/", and
+	// environment names do not contain ".".
+	i := strings.Index(s, "/")
+	j := strings.Index(s[i+1:], ".") + i + 1
+	if i < 0 || j <= i || !IsValidUser(s[:i]) || !matchEnvironName(s[i+1:j]) {
+		return EnvironRef{}, fmt.Errorf("%q is not a valid cross-environment reference", s)
+	}
+	r := EnvironRef{owner: NewUserTag(s[:i]), environ: s[i+1 : j]}
+	switch local := s[j+1:]; {
+	case IsValidService(local):
+		r.entity = NewServiceTag(local)
+	case IsValidUnit(local):
+		r.entity = NewUnitTag(local)
+	default:
+		return EnvironRef{}, fmt.Errorf("%q is not a valid cross-environment reference", s)
+	}
+	return r, nil
+}
+
+// Owner returns the tag of the user owning the environment.
+func (r EnvironRef) Owner() UserTag { return r.owner }
+
+// EnvironName returns the name of the environment.
+func (r EnvironRef) EnvironName() string { return r.environ }
+
+// Entity returns the tag of the service or unit in the environment.
+func (r EnvironRef) Entity() Tag { return r.entity }
+
+// String returns the reference in the form "admin/prod.mysql/0".
+func (r EnvironRef) String() string {
+	return r.owner.Id() + "/" + r.environ + "." + r.entity.Id()
+}
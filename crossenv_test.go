@@ -0,0 +1,85 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type environRefSuite struct{}
+
+var _ = gc.Suite(&environRefSuite{})
+
+var parseEnvironRefTests = []struct {
+	ref     string
+	owner   string
+	environ string
+	entity  names.Tag
+	err     string
+}{{
+	ref:     "admin/prod.mysql",
+	owner:   "admin",
+	environ: "prod",
+	entity:  names.NewServiceTag("mysql"),
+}, {
+	ref:     "admin/prod.mysql/0",
+	owner:   "admin",
+	environ: "prod",
+	entity:  names.NewUnitTag("mysql/0"),
+}, {
+	ref:     "bob.smith@local/staging-2.wordpress-site/12",
+	owner:   "bob.smith@local",
+	environ: "staging-2",
+	entity:  names.NewUnitTag("wordpress-site/12"),
+}, {
+	ref: "prod.mysql",
+	err: `"prod.mysql" is not a valid cross-environment reference`,
+}, {
+	ref: "prod.mysql/0",
+	err: `"prod.mysql/0" is not a valid cross-environment reference`,
+}, {
+	ref: "admin/prod",
+	err: `"admin/prod" is not a valid cross-environment reference`,
+}, {
+	ref: "admin/Prod.mysql",
+	err: `"admin/Prod.mysql" is not a valid cross-environment reference`,
+}, {
+	ref: "admin/prod.mysql/01",
+	err: `"admin/prod.mysql/01" is not a valid cross-environment reference`,
+}, {
+	ref: "admin/prod.",
+	err: `"admin/prod." is not a valid cross-environment reference`,
+}, {
+	ref: "/prod.mysql",
+	err: `"/prod.mysql" is not a valid cross-environment reference`,
+}}
+
+func (s *environRefSuite) TestParseEnvironRef(c *gc.C) {
+	for i, test := range parseEnvironRefTests {
+		c.Logf("test %d: %q", i, test.ref)
+		r, err := names.ParseEnvironRef(test.ref)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+		c.Check(r.Owner(), gc.Equals, names.NewUserTag(test.owner))
+		c.Check(r.EnvironName(), gc.Equals, test.environ)
+		c.Check(r.Entity(), gc.Equals, test.entity)
+		c.Check(r.String(), gc.Equals, test.ref)
+	}
+}
+
+func (s *environRefSuite) TestNewEnvironRef(c *gc.C) {
+	r, err := names.NewEnvironRef(names.NewUserTag("admin"), "prod", names.NewUnitTag("mysql/0"))
+	c.Assert(err, gc.IsNil)
+	c.Check(r.String(), gc.Equals, "admin/prod.mysql/0")
+
+	_, err = names.NewEnvironRef(names.NewUserTag("admin"), "prod", names.NewMachineTag("0"))
+	c.Check(err, gc.ErrorMatches, `cannot refer to "machine-0" in another environment`)
+	_, err = names.NewEnvironRef(names.NewUserTag("admin"), "prod.db", names.NewServiceTag("mysql"))
+	c.Check(err, gc.ErrorMatches, `"prod.db" is not a valid environment name`)
+}
@@ -0,0 +1,131 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+)
+
+// TagDictionary assigns stable integer codes to tags, so that indexes
+// and columnar stores can refer to entities by small integers. Codes
+// are assigned in order of first use, starting at 1; 0 is never a
+// valid code. The zero value is an empty dictionary ready to use, and
+// a TagDictionary is safe for concurrent use.
+//
+// The mapping is saved with WriteTo and restored with ReadFrom; codes
+// are preserved across a save and load.
+type TagDictionary struct {
+	mu    sync.RWMutex
+	codes map[string]uint32
+	tags  []Tag
+}
+
+// Code returns the code for t, assigning a new one if t has not been
+// seen before.
+func (d *TagDictionary) Code(t Tag) uint32 {
+	key := t.String()
+	d.mu.RLock()
+	code, ok := d.codes[key]
+	d.mu.RUnlock()
+	if ok {
+		return code
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if code, ok := d.codes[key]; ok {
+		return code
+	}
+	return d.add(key, t)
+}
+
+// Lookup returns the code for t, if it has one.
+func (d *TagDictionary) Lookup(t Tag) (uint32, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	code, ok := d.codes[t.String()]
+	return code, ok
+}
+
+// Tag returns the tag with the given code, if there is one.
+func (d *TagDictionary) Tag(code uint32) (Tag, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if code == 0 || uint64(code) > uint64(len(d.tags)) {
+		return nil, false
+	}
+	return d.tags[code-1], true
+}
+
+// Len returns the number of tags in the dictionary.
+func (d *TagDictionary) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.tags)
+}
+
+// WriteTo writes the dictionary to w as lines of tag strings, in code
+// order. It implements io.WriterTo.
+func (d *TagDictionary) WriteTo(w io.Writer) (int64, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	bw := bufio.NewWriter(w)
+	var written int64
+	for _, t := range d.tags {
+		n, err := bw.WriteString(t.String() + "\n")
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, bw.Flush()
+}
+
+// ReadFrom loads a dictionary written by WriteTo into d, which must be
+// empty. It implements io.ReaderFrom.
+func (d *TagDictionary) ReadFrom(r io.Reader) (int64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.tags) > 0 {
+		return 0, fmt.Errorf("cannot load into non-empty tag dictionary")
+	}
+	var read int64
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		read += int64(len(scanner.Bytes())) + 1
+		t, err := ParseTag(scanner.Text())
+		if err == nil {
+			if _, ok := d.codes[t.String()]; ok {
+				err = fmt.Errorf("duplicate tag %q", t.String())
+			}
+		}
+		if err != nil {
+			d.codes, d.tags = nil, nil
+			return read, fmt.Errorf("tag dictionary line %d: %v", line, err)
+		}
+		d.add(t.String(), t)
+	}
+	if err := scanner.Err(); err != nil {
+		d.codes, d.tags = nil, nil
+		return read, err
+	}
+	return read, nil
+}
+
+// add assigns the next code to t. The caller must hold the write lock.
+func (d *TagDictionary) add(key string, t Tag) uint32 {
+	if d.codes == nil {
+		d.codes = make(map[string]uint32)
+	}
+	if uint64(len(d.tags)) == math.MaxUint32 {
+		panic("tag dictionary is full")
+	}
+	d.tags = append(d.tags, t)
+	code := uint32(len(d.tags))
+	d.codes[key] = code
+	return code
+}
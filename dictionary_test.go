@@ -0,0 +1,76 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"bytes"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type dictionarySuite struct{}
+
+var _ = gc.Suite(&dictionarySuite{})
+
+func (s *dictionarySuite) TestCodes(c *gc.C) {
+	var d names.TagDictionary
+	unit := names.NewUnitTag("mysql/0")
+	machine := names.NewMachineTag("0/lxc/1")
+
+	_, ok := d.Lookup(unit)
+	c.Check(ok, gc.Equals, false)
+	c.Check(d.Code(unit), gc.Equals, uint32(1))
+	c.Check(d.Code(machine), gc.Equals, uint32(2))
+	c.Check(d.Code(unit), gc.Equals, uint32(1))
+	c.Check(d.Len(), gc.Equals, 2)
+
+	code, ok := d.Lookup(machine)
+	c.Check(ok, gc.Equals, true)
+	c.Check(code, gc.Equals, uint32(2))
+	tag, ok := d.Tag(2)
+	c.Check(ok, gc.Equals, true)
+	c.Check(tag, gc.Equals, machine)
+	_, ok = d.Tag(0)
+	c.Check(ok, gc.Equals, false)
+	_, ok = d.Tag(3)
+	c.Check(ok, gc.Equals, false)
+}
+
+func (s *dictionarySuite) TestSaveLoad(c *gc.C) {
+	var d names.TagDictionary
+	d.Code(names.NewServiceTag("wordpress"))
+	d.Code(names.NewUnitTag("wordpress/0"))
+	d.Code(names.NewUserTag("bob@local"))
+
+	var buf bytes.Buffer
+	n, err := d.WriteTo(&buf)
+	c.Assert(err, gc.IsNil)
+	c.Check(buf.String(), gc.Equals, "service-wordpress\nunit-wordpress-0\nuser-bob@local\n")
+	c.Check(n, gc.Equals, int64(buf.Len()))
+
+	var loaded names.TagDictionary
+	n, err = loaded.ReadFrom(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, gc.IsNil)
+	c.Check(n, gc.Equals, int64(buf.Len()))
+	c.Check(loaded.Len(), gc.Equals, 3)
+	c.Check(loaded.Code(names.NewUnitTag("wordpress/0")), gc.Equals, uint32(2))
+	c.Check(loaded.Code(names.NewMachineTag("1")), gc.Equals, uint32(4))
+
+	_, err = loaded.ReadFrom(strings.NewReader(""))
+	c.Check(err, gc.ErrorMatches, "cannot load into non-empty tag dictionary")
+}
+
+func (s *dictionarySuite) TestLoadErrors(c *gc.C) {
+	var d names.TagDictionary
+	_, err := d.ReadFrom(strings.NewReader("machine-0\nunit-mysql\n"))
+	c.Check(err, gc.ErrorMatches, `tag dictionary line 2: "unit-mysql" is not a valid unit tag`)
+	c.Check(d.Len(), gc.Equals, 0)
+
+	_, err = d.ReadFrom(strings.NewReader("machine-0\nmachine-0\n"))
+	c.Check(err, gc.ErrorMatches, `tag dictionary line 2: duplicate tag "machine-0"`)
+	c.Check(d.Len(), gc.Equals, 0)
+}
@@ -0,0 +1,134 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// DiffStrings returns a readable description of the differences
+// between two slices of tags, intended for test failure messages. The
+// result is empty if the slices hold the same tags in the same order.
+// Otherwise differences are grouped by kind, with tags in want but not
+// in got marked "-", tags in got but not in want marked "+", and tags
+// present in both but out of order marked "~". For example:
+//
+//	unit:
+//	  - mysql/1 (want[1])
+//	  ~ mysql/0 (got[2], want[0])
+//	machine:
+//	  + 3 (got[1])
+func DiffStrings(got, want []Tag) string {
+	// Pair up occurrences of the same tag in both slices, in order.
+	wantAt := make(map[string][]int)
+	for i, t := range want {
+		wantAt[t.String()] = append(wantAt[t.String()], i)
+	}
+	var entries, paired []*diffEntry
+	for i, t := range got {
+		e := &diffEntry{tag: t, gotAt: i, wantAt: -1}
+		if at := wantAt[t.String()]; len(at) > 0 {
+			e.wantAt, wantAt[t.String()] = at[0], at[1:]
+			paired = append(paired, e)
+		}
+		entries = append(entries, e)
+	}
+	for i, t := range want {
+		if at := wantAt[t.String()]; len(at) > 0 && at[0] == i {
+			wantAt[t.String()] = at[1:]
+			entries = append(entries, &diffEntry{tag: t, gotAt: -1, wantAt: i})
+		}
+	}
+
+	// Tags that are in both slices have kept their relative order if
+	// they lie on the longest run that is increasing in both; the
+	// rest have moved.
+	sort.Sort(byWantIndex(paired))
+	gotOrder := make([]int, len(paired))
+	for i, e := range paired {
+		gotOrder[i] = e.gotAt
+	}
+	inOrder := longestIncreasing(gotOrder)
+	for i, e := range paired {
+		e.moved = !inOrder[i]
+	}
+
+	// Group the differences by kind, in order of first appearance.
+	var kinds []string
+	byKind := make(map[string][]string)
+	for _, e := range entries {
+		var line string
+		switch {
+		case e.wantAt < 0:
+			line = fmt.Sprintf("  + %s (got[%d])", e.tag.Id(), e.gotAt)
+		case e.gotAt < 0:
+			line = fmt.Sprintf("  - %s (want[%d])", e.tag.Id(), e.wantAt)
+		case e.moved:
+			line = fmt.Sprintf("  ~ %s (got[%d], want[%d])", e.tag.Id(), e.gotAt, e.wantAt)
+		default:
+			continue
+		}
+		kind := e.tag.Kind()
+		if _, ok := byKind[kind]; !ok {
+			kinds = append(kinds, kind)
+		}
+		byKind[kind] = append(byKind[kind], line)
+	}
+	var buf bytes.Buffer
+	for _, kind := range kinds {
+		fmt.Fprintf(&buf, "%s:\n", kind)
+		for _, line := range byKind[kind] {
+			fmt.Fprintln(&buf, line)
+		}
+	}
+	return buf.String()
+}
+
+// diffEntry records an occurrence of a tag in either or both of the
+// slices compared by DiffStrings; an index of -1 means the tag does
+// not occur in that slice.
+type diffEntry struct {
+	tag    Tag
+	gotAt  int
+	wantAt int
+	moved  bool
+}
+
+type byWantIndex []*diffEntry
+
+func (s byWantIndex) Len() int           { return len(s) }
+func (s byWantIndex) Less(i, j int) bool { return s[i].wantAt < s[j].wantAt }
+func (s byWantIndex) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// longestIncreasing reports, for each element of s, whether it is part
+// of a longest strictly increasing subsequence of s.
+func longestIncreasing(s []int) []bool {
+	// tails[k] holds the index of the smallest element ending an
+	// increasing subsequence of length k+1; prev links each element
+	// to its predecessor in the subsequence it ends.
+	var tails []int
+	prev := make([]int, len(s))
+	for i, v := range s {
+		k := sort.Search(len(tails), func(j int) bool { return s[tails[j]] >= v })
+		if k > 0 {
+			prev[i] = tails[k-1]
+		} else {
+			prev[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	in := make([]bool, len(s))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			in[i] = true
+		}
+	}
+	return in
+}
@@ -0,0 +1,83 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type diffSuite struct{}
+
+var _ = gc.Suite(&diffSuite{})
+
+func mustParseTags(s ...string) []names.Tag {
+	var result []names.Tag
+	for _, str := range s {
+		tag, err := names.ParseTag(str)
+		if err != nil {
+			panic(err)
+		}
+		result = append(result, tag)
+	}
+	return result
+}
+
+var diffTests = []struct {
+	about  string
+	got    []names.Tag
+	want   []names.Tag
+	expect string
+}{{
+	about: "equal",
+	got:   mustParseTags("unit-mysql-0", "machine-1"),
+	want:  mustParseTags("unit-mysql-0", "machine-1"),
+}, {
+	about: "empty",
+}, {
+	about: "missing and extra",
+	got:   mustParseTags("unit-mysql-0", "machine-3"),
+	want:  mustParseTags("unit-mysql-0", "unit-mysql-1"),
+	expect: `
+machine:
+  + 3 (got[1])
+unit:
+  - mysql/1 (want[1])
+`[1:],
+}, {
+	about: "a removal does not report later tags as moved",
+	got:   mustParseTags("machine-1", "machine-2"),
+	want:  mustParseTags("machine-0", "machine-1", "machine-2"),
+	expect: `
+machine:
+  - 0 (want[0])
+`[1:],
+}, {
+	about: "reordered",
+	got:   mustParseTags("unit-mysql-1", "unit-mysql-2", "service-mysql", "unit-mysql-0"),
+	want:  mustParseTags("service-mysql", "unit-mysql-0", "unit-mysql-1", "unit-mysql-2"),
+	expect: `
+service:
+  ~ mysql (got[2], want[0])
+unit:
+  ~ mysql/0 (got[3], want[1])
+`[1:],
+}, {
+	about: "duplicates",
+	got:   mustParseTags("machine-1", "machine-1", "machine-1"),
+	want:  mustParseTags("machine-1"),
+	expect: `
+machine:
+  + 1 (got[1])
+  + 1 (got[2])
+`[1:],
+}}
+
+func (s *diffSuite) TestDiffStrings(c *gc.C) {
+	for i, test := range diffTests {
+		c.Logf("test %d: %s", i, test.about)
+		c.Check(names.DiffStrings(test.got, test.want), gc.Equals, test.expect)
+	}
+}
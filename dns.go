@@ -0,0 +1,75 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"strings"
+)
+
+// MaxDNSLabelLength is the maximum length of a DNS label (RFC 1123).
+const MaxDNSLabelLength = 63
+
+// dnsHashLength is the number of characters of the hash that
+// ToDNSLabel appends to labels that do not hold the whole tag.
+const dnsHashLength = 10
+
+var dnsHashEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// ToDNSLabel returns a valid DNS label (RFC 1123) derived from t, for
+// naming cloud resources after entities. Where the string
+// representation of t is itself a valid label, such as "unit-mysql-0",
+// it is returned unchanged. Otherwise upper-case letters are converted
+// to lower case, other invalid characters are replaced by hyphens, the
+// result is truncated if it is too long, and two hyphens and a hash of
+// the whole tag are appended, so that distinct tags yield distinct
+// labels.
+func ToDNSLabel(t Tag) (string, error) {
+	if t == nil {
+		return "", fmt.Errorf("no tag to convert to a DNS label")
+	}
+	s := t.String()
+	label := []byte(s)
+	lossy := false
+	for i, c := range label {
+		switch {
+		case 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-':
+		case 'A' <= c && c <= 'Z':
+			label[i] = c - 'A' + 'a'
+			lossy = true
+		default:
+			label[i] = '-'
+			lossy = true
+		}
+	}
+	if !lossy && len(label) <= MaxDNSLabelLength && label[len(label)-1] != '-' {
+		return s, nil
+	}
+	if max := MaxDNSLabelLength - dnsHashLength - 2; len(label) > max {
+		label = label[:max]
+	}
+	// Tags always start with their kind, so the label never becomes
+	// empty, and never starts with a hyphen.
+	label = []byte(strings.TrimRight(string(label), "-"))
+	sum := sha256.Sum256([]byte(s))
+	hash := dnsHashEncoding.EncodeToString(sum[:])[:dnsHashLength]
+	return string(label) + "--" + hash, nil
+}
+
+// TagFromDNSLabel makes a best-effort attempt to recover the tag from
+// which ToDNSLabel derived label. It succeeds only when label holds the
+// whole tag; the tags of labels that were shortened or had characters
+// replaced cannot be recovered, and must be looked up by other means.
+// Since those labels hold a double hyphen, tags whose string
+// representation holds one are not recovered either.
+func TagFromDNSLabel(label string) (Tag, error) {
+	if !strings.Contains(label, "--") {
+		if t, err := ParseTag(label); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot recover tag from DNS label %q", label)
+}
@@ -0,0 +1,92 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type dnsSuite struct{}
+
+var _ = gc.Suite(&dnsSuite{})
+
+var dnsLabelPattern = `[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?`
+
+var dnsLabelTests = []struct {
+	tag   names.Tag
+	label string
+}{
+	{names.NewUnitTag("mysql/0"), "unit-mysql-0"},
+	{names.NewMachineTag("0/lxc/1"), "machine-0-lxc-1"},
+	{names.NewServiceTag("wordpress"), "service-wordpress"},
+	{names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "environment-f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	{names.NewUserTag("Bob@local"), `user-bob-local--[a-z2-7]{10}`},
+	{names.NewRelationTag("wordpress:db mysql:server"), `relation-wordpress-db-mysql-server--[a-z2-7]{10}`},
+	{names.JoinActionTag("mysql/0", 3), `action-mysql-0-a-3--[a-z2-7]{10}`},
+	{names.NewServiceTag(strings.Repeat("a", 60)), `service-a{43}--[a-z2-7]{10}`},
+}
+
+func (s *dnsSuite) TestToDNSLabel(c *gc.C) {
+	for i, test := range dnsLabelTests {
+		c.Logf("test %d: %s", i, test.tag)
+		label, err := names.ToDNSLabel(test.tag)
+		c.Assert(err, gc.IsNil)
+		c.Check(label, gc.Matches, test.label)
+		c.Check(label, gc.Matches, dnsLabelPattern)
+	}
+}
+
+func (s *dnsSuite) TestToDNSLabelAllTypes(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %s", i, test.tag)
+		label, err := names.ToDNSLabel(test.tag)
+		c.Assert(err, gc.IsNil)
+		c.Check(label, gc.Matches, dnsLabelPattern)
+	}
+}
+
+func (s *dnsSuite) TestToDNSLabelDistinct(c *gc.C) {
+	// These tags differ only in characters that are not valid in
+	// labels.
+	seen := make(map[string]names.Tag)
+	for _, t := range []names.Tag{
+		names.NewUserTag("bob@local"),
+		names.NewUserTag("bob.local"),
+		names.NewUserTag("Bob.local"),
+		names.NewUserTag("bob-local"),
+		names.NewServiceTag(strings.Repeat("a", 60)),
+		names.NewServiceTag(strings.Repeat("a", 61)),
+	} {
+		label, err := names.ToDNSLabel(t)
+		c.Assert(err, gc.IsNil)
+		c.Check(seen[label], gc.IsNil, gc.Commentf("%s and %s both yield %s", seen[label], t, label))
+		seen[label] = t
+	}
+}
+
+func (s *dnsSuite) TestToDNSLabelNil(c *gc.C) {
+	_, err := names.ToDNSLabel(nil)
+	c.Check(err, gc.ErrorMatches, "no tag to convert to a DNS label")
+}
+
+func (s *dnsSuite) TestTagFromDNSLabel(c *gc.C) {
+	for i, test := range dnsLabelTests[:4] {
+		c.Logf("test %d: %s", i, test.label)
+		t, err := names.TagFromDNSLabel(test.label)
+		c.Assert(err, gc.IsNil)
+		c.Check(t, gc.Equals, test.tag)
+	}
+	label, err := names.ToDNSLabel(names.NewUserTag("bob@local"))
+	c.Assert(err, gc.IsNil)
+	_, err = names.TagFromDNSLabel(label)
+	c.Check(err, gc.ErrorMatches, `cannot recover tag from DNS label "user-bob-local--.*"`)
+	_, err = names.TagFromDNSLabel("user-bob--local")
+	c.Check(err, gc.ErrorMatches, `cannot recover tag from DNS label "user-bob--local"`)
+	_, err = names.TagFromDNSLabel("mysql")
+	c.Check(err, gc.ErrorMatches, `cannot recover tag from DNS label "mysql"`)
+}
@@ -0,0 +1,203 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+//go:generate go run gentags.go
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+// Each concrete tag type implements the encoding interfaces in
+// encoding_gen.go, generated by gentags.go. Every encoding except the
+// compact binary one (see binary.go) holds a tag as its string
+// representation, and decoding validates the tag as its Parse function
+// does, but ignores any default parser (see decodeTag). The zero value of a tag type is encoded as an empty string, or
+// empty data, and decoding that yields the zero value.
+//
+// The BSON methods, in encoding_bson_gen.go, are built only with the
+// names_bson build tag, so that packages that do not store tags with
+// the mgo driver do not depend on it.
+
+// CBOR initial bytes (RFC 8949, section 3).
+const (
+	cborTextString = 3 << 5
+	cborNull       = 0xf6
+)
+
+// appendCBORString appends the CBOR encoding of s, as a definite
+// length text string, to dst.
+func appendCBORString(dst []byte, s string) []byte {
+	n := uint64(len(s))
+	switch {
+	case n < 24:
+		dst = append(dst, cborTextString|byte(n))
+	case n <= 0xff:
+		dst = append(dst, cborTextString|24, byte(n))
+	case n <= 0xffff:
+		dst = binary.BigEndian.AppendUint16(append(dst, cborTextString|25), uint16(n))
+	case n <= 0xffffffff:
+		dst = binary.BigEndian.AppendUint32(append(dst, cborTextString|26), uint32(n))
+	default:
+		dst = binary.BigEndian.AppendUint64(append(dst, cborTextString|27), n)
+	}
+	return append(dst, s...)
+}
+
+// cborString decodes the single CBOR data item in data, which must be
+// a definite length text string or null. Null decodes as the empty
+// string.
+func cborString(data []byte) (string, error) {
+	if len(data) == 1 && data[0] == cborNull {
+		return "", nil
+	}
+	if len(data) == 0 || data[0]&^0x1f != cborTextString {
+		return "", fmt.Errorf("cannot decode CBOR tag: not a text string")
+	}
+	info, data := data[0]&0x1f, data[1:]
+	var n uint64
+	switch {
+	case info < 24:
+		n = uint64(info)
+	case info <= 27:
+		// The length follows in 1, 2, 4 or 8 bytes.
+		size := 1 << (info - 24)
+		if len(data) < size {
+			return "", fmt.Errorf("cannot decode CBOR tag: unexpected end of data")
+		}
+		for _, b := range data[:size] {
+			n = n<<8 | uint64(b)
+		}
+		data = data[size:]
+	default:
+		return "", fmt.Errorf("cannot decode CBOR tag: unsupported string length encoding")
+	}
+	if n != uint64(len(data)) {
+		return "", fmt.Errorf("cannot decode CBOR tag: string length %d does not match data", n)
+	}
+	return string(data), nil
+}
+
+// MessagePack format bytes (see github.com/msgpack/msgpack/spec.md).
+const (
+	msgpackNil    = 0xc0
+	msgpackFixStr = 0xa0
+	msgpackStr8   = 0xd9
+	msgpackStr16  = 0xda
+	msgpackStr32  = 0xdb
+)
+
+// appendMsgpackString appends the MessagePack encoding of s, as a str,
+// to dst.
+func appendMsgpackString(dst []byte, s string) []byte {
+	n := len(s)
+	switch {
+	case n < 32:
+		dst = append(dst, msgpackFixStr|byte(n))
+	case n <= 0xff:
+		dst = append(dst, msgpackStr8, byte(n))
+	case n <= 0xffff:
+		dst = binary.BigEndian.AppendUint16(append(dst, msgpackStr16), uint16(n))
+	default:
+		dst = binary.BigEndian.AppendUint32(append(dst, msgpackStr32), uint32(n))
+	}
+	return append(dst, s...)
+}
+
+// msgpackString decodes the single MessagePack object in data, which
+// must be a str or nil. Nil decodes as the empty string.
+func msgpackString(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", fmt.Errorf("cannot decode MessagePack tag: no data")
+	}
+	var size int
+	switch b := data[0]; {
+	case b == msgpackNil && len(data) == 1:
+		return "", nil
+	case b&0xe0 == msgpackFixStr:
+		return msgpackBytes(data[1:], uint64(b&0x1f))
+	case b == msgpackStr8:
+		size = 1
+	case b == msgpackStr16:
+		size = 2
+	case b == msgpackStr32:
+		size = 4
+	default:
+		return "", fmt.Errorf("cannot decode MessagePack tag: not a string")
+	}
+	data = data[1:]
+	if len(data) < size {
+		return "", fmt.Errorf("cannot decode MessagePack tag: unexpected end of data")
+	}
+	var n uint64
+	for _, b := range data[:size] {
+		n = n<<8 | uint64(b)
+	}
+	return msgpackBytes(data[size:], n)
+}
+
+func msgpackBytes(data []byte, n uint64) (string, error) {
+	if n != uint64(len(data)) {
+		return "", fmt.Errorf("cannot decode MessagePack tag: string length %d does not match data", n)
+	}
+	return string(data), nil
+}
+
+// decodeTag parses the string representation of a tag of type T, as
+// held by an encoding. Encoded tags were written by this package, so
+// they are parsed as written, without the hooks of any default parser.
+func decodeTag[T Tag](s string) (T, error) {
+	var tag T
+	if p, ok := any(&tag).(*UserTag); ok {
+		// Users whose ids are email addresses can only be made by a
+		// UserValidator, but their tags are decoded like any other.
+		var err error
+		*p, err = UserValidator{AllowEmail: true}.ParseUserTag(s)
+		return tag, err
+	}
+	t, err := parseTag(s)
+	if err != nil {
+		return tag, err
+	}
+	switch p := any(&tag).(type) {
+	case *AgentTag:
+		if *p, err = NewAgentTag(t); err != nil {
+			return tag, invalidTagError(s, "agent")
+		}
+		return tag, nil
+	case *ApplicationTag:
+		// Service tags are accepted, as by ParseApplicationTag.
+		if st, ok := t.(ServiceTag); ok {
+			*p = st.ApplicationTag()
+			return tag, nil
+		}
+	}
+	tag, ok := t.(T)
+	if !ok {
+		return tag, invalidTagError(s, tag.Kind())
+	}
+	return tag, nil
+}
+
+// stringAppender is implemented by the tag types.
+type stringAppender interface {
+	AppendString(dst []byte) []byte
+}
+
+// appendQuotedTag appends the string representation of t to dst as a
+// double-quoted Go string literal, as strconv.AppendQuote does.
+func appendQuotedTag[T stringAppender](dst []byte, t T) []byte {
+	start := len(dst)
+	dst = t.AppendString(append(dst, '"'))
+	for _, c := range dst[start+1:] {
+		if c < ' ' || c > '~' || c == '"' || c == '\\' {
+			// Valid tags never need escaping, so there is no
+			// need to avoid allocating here.
+			return strconv.AppendQuote(dst[:start], string(dst[start+1:]))
+		}
+	}
+	return append(dst, '"')
+}
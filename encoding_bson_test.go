@@ -0,0 +1,65 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+//go:build names_bson
+
+package names_test
+
+import (
+	"reflect"
+
+	gc "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/juju/names"
+)
+
+func (s *encodingSuite) TestBSON(c *gc.C) {
+	var doc struct {
+		Tag bson.Raw `bson:"tag"`
+	}
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		data, err := bson.Marshal(bson.M{"tag": test.tag})
+		c.Assert(err, gc.IsNil)
+		c.Assert(bson.Unmarshal(data, &doc), gc.IsNil)
+		var raw string
+		c.Assert(doc.Tag.Unmarshal(&raw), gc.IsNil)
+		c.Check(raw, gc.Equals, test.tag.String())
+
+		decoded := newTag(test.tag)
+		c.Assert(doc.Tag.Unmarshal(decoded), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, test.tag)
+
+		data, err = bson.Marshal(bson.M{"tag": test.invalid})
+		c.Assert(err, gc.IsNil)
+		c.Assert(bson.Unmarshal(data, &doc), gc.IsNil)
+		err = doc.Tag.Unmarshal(newTag(test.tag))
+		c.Check(err, gc.ErrorMatches, `"`+test.invalid+`" is not a valid .*tag`)
+	}
+}
+
+func (s *encodingSuite) TestBSONStruct(c *gc.C) {
+	type unitDoc struct {
+		Unit    names.UnitTag    `bson:"unit"`
+		Machine names.MachineTag `bson:"machine"`
+		Service names.ServiceTag `bson:"service,omitempty"`
+	}
+	d := unitDoc{
+		Unit:    names.NewUnitTag("mysql/0"),
+		Machine: names.NewMachineTag("0/lxc/1"),
+	}
+	data, err := bson.Marshal(d)
+	c.Assert(err, gc.IsNil)
+	var raw bson.M
+	c.Assert(bson.Unmarshal(data, &raw), gc.IsNil)
+	c.Check(raw, gc.DeepEquals, bson.M{"unit": "unit-mysql-0", "machine": "machine-0-lxc-1"})
+	var decoded unitDoc
+	c.Assert(bson.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.Equals, d)
+
+	data, err = bson.Marshal(bson.M{"unit": "unit-mysql"})
+	c.Assert(err, gc.IsNil)
+	err = bson.Unmarshal(data, &decoded)
+	c.Check(err, gc.ErrorMatches, `"unit-mysql" is not a valid unit tag`)
+}
@@ -0,0 +1,7192 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Code generated by gentags.go; DO NOT EDIT.
+
+package names
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+func init() {
+	gob.Register(UnitTag{})
+	gob.Register(MachineTag{})
+	gob.Register(ServiceTag{})
+	gob.Register(EnvironTag{})
+	gob.Register(UserTag{})
+	gob.Register(RelationTag{})
+	gob.Register(NetworkTag{})
+	gob.Register(ActionTag{})
+	gob.Register(ActionResultTag{})
+	gob.Register(VolumeTag{})
+	gob.Register(FilesystemTag{})
+	gob.Register(StorageTag{})
+	gob.Register(StorageAttachmentTag{})
+	gob.Register(VolumeAttachmentTag{})
+	gob.Register(FilesystemAttachmentTag{})
+	gob.Register(SpaceTag{})
+	gob.Register(SubnetTag{})
+	gob.Register(IPAddressTag{})
+	gob.Register(ModelTag{})
+	gob.Register(ControllerTag{})
+	gob.Register(ControllerAgentTag{})
+	gob.Register(CloudTag{})
+	gob.Register(CloudCredentialTag{})
+	gob.Register(ApplicationTag{})
+	gob.Register(ApplicationOfferTag{})
+	gob.Register(RemoteApplicationTag{})
+	gob.Register(RemoteRelationTag{})
+	gob.Register(OperationTag{})
+	gob.Register(SecretTag{})
+	gob.Register(SecretBackendTag{})
+	gob.Register(CharmTag{})
+	gob.Register(ResourceTag{})
+	gob.Register(MetricBatchTag{})
+	gob.Register(InstanceTag{})
+	gob.Register(AvailabilityZoneTag{})
+	gob.Register(CloudRegionTag{})
+	gob.Register(ImageTag{})
+	gob.Register(SSHKeyTag{})
+	gob.Register(CertificateTag{})
+	gob.Register(BackupTag{})
+	gob.Register(UpgradeTag{})
+	gob.Register(MigrationTag{})
+	gob.Register(LeaseTag{})
+	gob.Register(AuditEntryTag{})
+	gob.Register(TokenTag{})
+	gob.Register(AgentTag{})
+}
+
+//
+// UnitTag
+//
+
+func (t UnitTag) encoded() string {
+	if t == (UnitTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *UnitTag) decode(s string) error {
+	if s == "" {
+		*t = UnitTag{}
+		return nil
+	}
+	tag, err := decodeTag[UnitTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t UnitTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *UnitTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t UnitTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *UnitTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t UnitTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *UnitTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t UnitTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *UnitTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t UnitTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *UnitTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t UnitTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *UnitTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t UnitTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *UnitTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = UnitTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t UnitTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t UnitTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t UnitTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// MachineTag
+//
+
+func (t MachineTag) encoded() string {
+	if t == (MachineTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *MachineTag) decode(s string) error {
+	if s == "" {
+		*t = MachineTag{}
+		return nil
+	}
+	tag, err := decodeTag[MachineTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t MachineTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *MachineTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t MachineTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *MachineTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t MachineTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *MachineTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t MachineTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *MachineTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t MachineTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *MachineTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t MachineTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *MachineTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t MachineTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *MachineTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = MachineTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t MachineTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t MachineTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t MachineTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ServiceTag
+//
+
+func (t ServiceTag) encoded() string {
+	if t == (ServiceTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ServiceTag) decode(s string) error {
+	if s == "" {
+		*t = ServiceTag{}
+		return nil
+	}
+	tag, err := decodeTag[ServiceTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ServiceTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ServiceTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ServiceTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ServiceTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ServiceTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ServiceTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ServiceTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ServiceTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ServiceTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ServiceTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ServiceTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ServiceTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ServiceTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ServiceTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ServiceTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ServiceTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ServiceTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ServiceTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// EnvironTag
+//
+
+func (t EnvironTag) encoded() string {
+	if t == (EnvironTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *EnvironTag) decode(s string) error {
+	if s == "" {
+		*t = EnvironTag{}
+		return nil
+	}
+	tag, err := decodeTag[EnvironTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t EnvironTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *EnvironTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t EnvironTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *EnvironTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t EnvironTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *EnvironTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t EnvironTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *EnvironTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t EnvironTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *EnvironTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t EnvironTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *EnvironTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t EnvironTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *EnvironTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = EnvironTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t EnvironTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t EnvironTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t EnvironTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// UserTag
+//
+
+func (t UserTag) encoded() string {
+	if t == (UserTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *UserTag) decode(s string) error {
+	if s == "" {
+		*t = UserTag{}
+		return nil
+	}
+	tag, err := decodeTag[UserTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t UserTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *UserTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t UserTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *UserTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t UserTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *UserTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t UserTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *UserTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t UserTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *UserTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t UserTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *UserTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t UserTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *UserTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = UserTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t UserTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t UserTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t UserTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// RelationTag
+//
+
+func (t RelationTag) encoded() string {
+	if t == (RelationTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *RelationTag) decode(s string) error {
+	if s == "" {
+		*t = RelationTag{}
+		return nil
+	}
+	tag, err := decodeTag[RelationTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t RelationTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *RelationTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t RelationTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *RelationTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t RelationTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *RelationTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t RelationTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *RelationTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t RelationTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *RelationTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t RelationTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *RelationTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t RelationTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *RelationTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = RelationTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t RelationTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t RelationTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t RelationTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// NetworkTag
+//
+
+func (t NetworkTag) encoded() string {
+	if t == (NetworkTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *NetworkTag) decode(s string) error {
+	if s == "" {
+		*t = NetworkTag{}
+		return nil
+	}
+	tag, err := decodeTag[NetworkTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t NetworkTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *NetworkTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t NetworkTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *NetworkTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t NetworkTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *NetworkTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t NetworkTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *NetworkTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t NetworkTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *NetworkTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t NetworkTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *NetworkTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t NetworkTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *NetworkTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = NetworkTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t NetworkTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t NetworkTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t NetworkTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ActionTag
+//
+
+func (t ActionTag) encoded() string {
+	if t == (ActionTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ActionTag) decode(s string) error {
+	if s == "" {
+		*t = ActionTag{}
+		return nil
+	}
+	tag, err := decodeTag[ActionTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ActionTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ActionTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ActionTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ActionTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ActionTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ActionTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ActionTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ActionTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ActionTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ActionTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ActionTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ActionTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ActionTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ActionTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ActionTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ActionTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ActionTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ActionTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ActionResultTag
+//
+
+func (t ActionResultTag) encoded() string {
+	if t == (ActionResultTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ActionResultTag) decode(s string) error {
+	if s == "" {
+		*t = ActionResultTag{}
+		return nil
+	}
+	tag, err := decodeTag[ActionResultTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ActionResultTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ActionResultTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ActionResultTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ActionResultTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ActionResultTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ActionResultTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ActionResultTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ActionResultTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ActionResultTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ActionResultTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ActionResultTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ActionResultTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ActionResultTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ActionResultTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ActionResultTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ActionResultTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ActionResultTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ActionResultTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// VolumeTag
+//
+
+func (t VolumeTag) encoded() string {
+	if t == (VolumeTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *VolumeTag) decode(s string) error {
+	if s == "" {
+		*t = VolumeTag{}
+		return nil
+	}
+	tag, err := decodeTag[VolumeTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t VolumeTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *VolumeTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t VolumeTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *VolumeTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t VolumeTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *VolumeTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t VolumeTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *VolumeTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t VolumeTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *VolumeTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t VolumeTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *VolumeTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t VolumeTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *VolumeTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = VolumeTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t VolumeTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t VolumeTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t VolumeTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// FilesystemTag
+//
+
+func (t FilesystemTag) encoded() string {
+	if t == (FilesystemTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *FilesystemTag) decode(s string) error {
+	if s == "" {
+		*t = FilesystemTag{}
+		return nil
+	}
+	tag, err := decodeTag[FilesystemTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t FilesystemTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *FilesystemTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t FilesystemTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *FilesystemTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t FilesystemTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *FilesystemTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t FilesystemTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *FilesystemTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t FilesystemTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *FilesystemTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t FilesystemTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *FilesystemTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t FilesystemTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *FilesystemTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = FilesystemTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t FilesystemTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t FilesystemTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t FilesystemTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// StorageTag
+//
+
+func (t StorageTag) encoded() string {
+	if t == (StorageTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *StorageTag) decode(s string) error {
+	if s == "" {
+		*t = StorageTag{}
+		return nil
+	}
+	tag, err := decodeTag[StorageTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t StorageTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *StorageTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t StorageTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *StorageTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t StorageTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *StorageTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t StorageTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *StorageTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t StorageTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *StorageTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t StorageTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *StorageTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t StorageTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *StorageTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = StorageTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t StorageTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t StorageTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t StorageTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// StorageAttachmentTag
+//
+
+func (t StorageAttachmentTag) encoded() string {
+	if t == (StorageAttachmentTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *StorageAttachmentTag) decode(s string) error {
+	if s == "" {
+		*t = StorageAttachmentTag{}
+		return nil
+	}
+	tag, err := decodeTag[StorageAttachmentTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t StorageAttachmentTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *StorageAttachmentTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t StorageAttachmentTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *StorageAttachmentTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t StorageAttachmentTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *StorageAttachmentTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t StorageAttachmentTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *StorageAttachmentTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t StorageAttachmentTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *StorageAttachmentTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t StorageAttachmentTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *StorageAttachmentTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t StorageAttachmentTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *StorageAttachmentTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = StorageAttachmentTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t StorageAttachmentTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t StorageAttachmentTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t StorageAttachmentTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// VolumeAttachmentTag
+//
+
+func (t VolumeAttachmentTag) encoded() string {
+	if t == (VolumeAttachmentTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *VolumeAttachmentTag) decode(s string) error {
+	if s == "" {
+		*t = VolumeAttachmentTag{}
+		return nil
+	}
+	tag, err := decodeTag[VolumeAttachmentTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t VolumeAttachmentTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *VolumeAttachmentTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t VolumeAttachmentTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *VolumeAttachmentTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t VolumeAttachmentTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *VolumeAttachmentTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t VolumeAttachmentTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *VolumeAttachmentTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t VolumeAttachmentTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *VolumeAttachmentTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t VolumeAttachmentTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *VolumeAttachmentTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t VolumeAttachmentTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *VolumeAttachmentTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = VolumeAttachmentTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t VolumeAttachmentTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t VolumeAttachmentTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t VolumeAttachmentTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// FilesystemAttachmentTag
+//
+
+func (t FilesystemAttachmentTag) encoded() string {
+	if t == (FilesystemAttachmentTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *FilesystemAttachmentTag) decode(s string) error {
+	if s == "" {
+		*t = FilesystemAttachmentTag{}
+		return nil
+	}
+	tag, err := decodeTag[FilesystemAttachmentTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t FilesystemAttachmentTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *FilesystemAttachmentTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t FilesystemAttachmentTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *FilesystemAttachmentTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t FilesystemAttachmentTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *FilesystemAttachmentTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t FilesystemAttachmentTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *FilesystemAttachmentTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t FilesystemAttachmentTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *FilesystemAttachmentTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t FilesystemAttachmentTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *FilesystemAttachmentTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t FilesystemAttachmentTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *FilesystemAttachmentTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = FilesystemAttachmentTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t FilesystemAttachmentTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t FilesystemAttachmentTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t FilesystemAttachmentTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// SpaceTag
+//
+
+func (t SpaceTag) encoded() string {
+	if t == (SpaceTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *SpaceTag) decode(s string) error {
+	if s == "" {
+		*t = SpaceTag{}
+		return nil
+	}
+	tag, err := decodeTag[SpaceTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t SpaceTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *SpaceTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t SpaceTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *SpaceTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t SpaceTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *SpaceTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t SpaceTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *SpaceTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t SpaceTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *SpaceTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t SpaceTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *SpaceTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t SpaceTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *SpaceTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = SpaceTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t SpaceTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t SpaceTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t SpaceTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// SubnetTag
+//
+
+func (t SubnetTag) encoded() string {
+	if t == (SubnetTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *SubnetTag) decode(s string) error {
+	if s == "" {
+		*t = SubnetTag{}
+		return nil
+	}
+	tag, err := decodeTag[SubnetTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t SubnetTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *SubnetTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t SubnetTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *SubnetTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t SubnetTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *SubnetTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t SubnetTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *SubnetTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t SubnetTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *SubnetTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t SubnetTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *SubnetTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t SubnetTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *SubnetTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = SubnetTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t SubnetTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t SubnetTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t SubnetTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// IPAddressTag
+//
+
+func (t IPAddressTag) encoded() string {
+	if t == (IPAddressTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *IPAddressTag) decode(s string) error {
+	if s == "" {
+		*t = IPAddressTag{}
+		return nil
+	}
+	tag, err := decodeTag[IPAddressTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t IPAddressTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *IPAddressTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t IPAddressTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *IPAddressTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t IPAddressTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *IPAddressTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t IPAddressTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *IPAddressTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t IPAddressTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *IPAddressTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t IPAddressTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *IPAddressTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t IPAddressTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *IPAddressTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = IPAddressTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t IPAddressTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t IPAddressTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t IPAddressTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ModelTag
+//
+
+func (t ModelTag) encoded() string {
+	if t == (ModelTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ModelTag) decode(s string) error {
+	if s == "" {
+		*t = ModelTag{}
+		return nil
+	}
+	tag, err := decodeTag[ModelTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ModelTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ModelTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ModelTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ModelTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ModelTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ModelTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ModelTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ModelTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ModelTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ModelTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ModelTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ModelTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ModelTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ModelTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ModelTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ModelTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ModelTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ModelTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ControllerTag
+//
+
+func (t ControllerTag) encoded() string {
+	if t == (ControllerTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ControllerTag) decode(s string) error {
+	if s == "" {
+		*t = ControllerTag{}
+		return nil
+	}
+	tag, err := decodeTag[ControllerTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ControllerTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ControllerTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ControllerTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ControllerTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ControllerTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ControllerTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ControllerTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ControllerTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ControllerTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ControllerTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ControllerTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ControllerTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ControllerTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ControllerTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ControllerTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ControllerTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ControllerTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ControllerTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ControllerAgentTag
+//
+
+func (t ControllerAgentTag) encoded() string {
+	if t == (ControllerAgentTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ControllerAgentTag) decode(s string) error {
+	if s == "" {
+		*t = ControllerAgentTag{}
+		return nil
+	}
+	tag, err := decodeTag[ControllerAgentTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ControllerAgentTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ControllerAgentTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ControllerAgentTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ControllerAgentTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ControllerAgentTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ControllerAgentTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ControllerAgentTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ControllerAgentTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ControllerAgentTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ControllerAgentTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ControllerAgentTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ControllerAgentTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ControllerAgentTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ControllerAgentTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ControllerAgentTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ControllerAgentTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ControllerAgentTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ControllerAgentTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// CloudTag
+//
+
+func (t CloudTag) encoded() string {
+	if t == (CloudTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *CloudTag) decode(s string) error {
+	if s == "" {
+		*t = CloudTag{}
+		return nil
+	}
+	tag, err := decodeTag[CloudTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t CloudTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *CloudTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t CloudTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *CloudTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t CloudTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *CloudTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t CloudTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *CloudTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t CloudTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *CloudTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t CloudTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *CloudTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t CloudTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *CloudTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = CloudTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t CloudTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t CloudTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t CloudTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// CloudCredentialTag
+//
+
+func (t CloudCredentialTag) encoded() string {
+	if t == (CloudCredentialTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *CloudCredentialTag) decode(s string) error {
+	if s == "" {
+		*t = CloudCredentialTag{}
+		return nil
+	}
+	tag, err := decodeTag[CloudCredentialTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t CloudCredentialTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *CloudCredentialTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t CloudCredentialTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *CloudCredentialTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t CloudCredentialTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *CloudCredentialTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t CloudCredentialTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *CloudCredentialTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t CloudCredentialTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *CloudCredentialTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t CloudCredentialTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *CloudCredentialTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t CloudCredentialTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *CloudCredentialTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = CloudCredentialTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t CloudCredentialTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t CloudCredentialTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t CloudCredentialTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ApplicationTag
+//
+
+func (t ApplicationTag) encoded() string {
+	if t == (ApplicationTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ApplicationTag) decode(s string) error {
+	if s == "" {
+		*t = ApplicationTag{}
+		return nil
+	}
+	tag, err := decodeTag[ApplicationTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ApplicationTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ApplicationTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ApplicationTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ApplicationTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ApplicationTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ApplicationTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ApplicationTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ApplicationTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ApplicationTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ApplicationTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ApplicationTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ApplicationTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ApplicationTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ApplicationTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ApplicationTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ApplicationTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ApplicationTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ApplicationTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ApplicationOfferTag
+//
+
+func (t ApplicationOfferTag) encoded() string {
+	if t == (ApplicationOfferTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ApplicationOfferTag) decode(s string) error {
+	if s == "" {
+		*t = ApplicationOfferTag{}
+		return nil
+	}
+	tag, err := decodeTag[ApplicationOfferTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ApplicationOfferTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ApplicationOfferTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ApplicationOfferTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ApplicationOfferTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ApplicationOfferTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ApplicationOfferTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ApplicationOfferTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ApplicationOfferTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ApplicationOfferTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ApplicationOfferTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ApplicationOfferTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ApplicationOfferTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ApplicationOfferTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ApplicationOfferTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ApplicationOfferTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ApplicationOfferTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ApplicationOfferTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ApplicationOfferTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// RemoteApplicationTag
+//
+
+func (t RemoteApplicationTag) encoded() string {
+	if t == (RemoteApplicationTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *RemoteApplicationTag) decode(s string) error {
+	if s == "" {
+		*t = RemoteApplicationTag{}
+		return nil
+	}
+	tag, err := decodeTag[RemoteApplicationTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t RemoteApplicationTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *RemoteApplicationTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t RemoteApplicationTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *RemoteApplicationTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t RemoteApplicationTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *RemoteApplicationTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t RemoteApplicationTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *RemoteApplicationTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t RemoteApplicationTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *RemoteApplicationTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t RemoteApplicationTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *RemoteApplicationTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t RemoteApplicationTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *RemoteApplicationTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = RemoteApplicationTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t RemoteApplicationTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t RemoteApplicationTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t RemoteApplicationTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// RemoteRelationTag
+//
+
+func (t RemoteRelationTag) encoded() string {
+	if t == (RemoteRelationTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *RemoteRelationTag) decode(s string) error {
+	if s == "" {
+		*t = RemoteRelationTag{}
+		return nil
+	}
+	tag, err := decodeTag[RemoteRelationTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t RemoteRelationTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *RemoteRelationTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t RemoteRelationTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *RemoteRelationTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t RemoteRelationTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *RemoteRelationTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t RemoteRelationTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *RemoteRelationTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t RemoteRelationTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *RemoteRelationTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t RemoteRelationTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *RemoteRelationTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t RemoteRelationTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *RemoteRelationTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = RemoteRelationTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t RemoteRelationTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t RemoteRelationTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t RemoteRelationTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// OperationTag
+//
+
+func (t OperationTag) encoded() string {
+	if t == (OperationTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *OperationTag) decode(s string) error {
+	if s == "" {
+		*t = OperationTag{}
+		return nil
+	}
+	tag, err := decodeTag[OperationTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t OperationTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *OperationTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t OperationTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *OperationTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t OperationTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *OperationTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t OperationTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *OperationTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t OperationTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *OperationTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t OperationTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *OperationTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t OperationTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *OperationTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = OperationTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t OperationTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t OperationTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t OperationTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// SecretTag
+//
+
+func (t SecretTag) encoded() string {
+	if t == (SecretTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *SecretTag) decode(s string) error {
+	if s == "" {
+		*t = SecretTag{}
+		return nil
+	}
+	tag, err := decodeTag[SecretTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t SecretTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *SecretTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t SecretTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *SecretTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t SecretTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *SecretTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t SecretTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *SecretTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t SecretTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *SecretTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t SecretTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *SecretTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t SecretTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *SecretTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = SecretTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t SecretTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t SecretTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t SecretTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// SecretBackendTag
+//
+
+func (t SecretBackendTag) encoded() string {
+	if t == (SecretBackendTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *SecretBackendTag) decode(s string) error {
+	if s == "" {
+		*t = SecretBackendTag{}
+		return nil
+	}
+	tag, err := decodeTag[SecretBackendTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t SecretBackendTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *SecretBackendTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t SecretBackendTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *SecretBackendTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t SecretBackendTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *SecretBackendTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t SecretBackendTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *SecretBackendTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t SecretBackendTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *SecretBackendTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t SecretBackendTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *SecretBackendTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t SecretBackendTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *SecretBackendTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = SecretBackendTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t SecretBackendTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t SecretBackendTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t SecretBackendTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// CharmTag
+//
+
+func (t CharmTag) encoded() string {
+	if t == (CharmTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *CharmTag) decode(s string) error {
+	if s == "" {
+		*t = CharmTag{}
+		return nil
+	}
+	tag, err := decodeTag[CharmTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t CharmTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *CharmTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t CharmTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *CharmTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t CharmTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *CharmTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t CharmTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *CharmTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t CharmTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *CharmTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t CharmTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *CharmTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t CharmTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *CharmTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = CharmTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t CharmTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t CharmTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t CharmTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ResourceTag
+//
+
+func (t ResourceTag) encoded() string {
+	if t == (ResourceTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ResourceTag) decode(s string) error {
+	if s == "" {
+		*t = ResourceTag{}
+		return nil
+	}
+	tag, err := decodeTag[ResourceTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ResourceTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ResourceTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ResourceTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ResourceTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ResourceTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ResourceTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ResourceTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ResourceTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ResourceTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ResourceTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ResourceTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ResourceTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ResourceTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ResourceTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ResourceTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ResourceTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ResourceTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ResourceTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// MetricBatchTag
+//
+
+func (t MetricBatchTag) encoded() string {
+	if t == (MetricBatchTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *MetricBatchTag) decode(s string) error {
+	if s == "" {
+		*t = MetricBatchTag{}
+		return nil
+	}
+	tag, err := decodeTag[MetricBatchTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t MetricBatchTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *MetricBatchTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t MetricBatchTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *MetricBatchTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t MetricBatchTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *MetricBatchTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t MetricBatchTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *MetricBatchTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t MetricBatchTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *MetricBatchTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t MetricBatchTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *MetricBatchTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t MetricBatchTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *MetricBatchTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = MetricBatchTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t MetricBatchTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t MetricBatchTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t MetricBatchTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// InstanceTag
+//
+
+func (t InstanceTag) encoded() string {
+	if t == (InstanceTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *InstanceTag) decode(s string) error {
+	if s == "" {
+		*t = InstanceTag{}
+		return nil
+	}
+	tag, err := decodeTag[InstanceTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t InstanceTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *InstanceTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t InstanceTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *InstanceTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t InstanceTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *InstanceTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t InstanceTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *InstanceTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t InstanceTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *InstanceTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t InstanceTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *InstanceTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t InstanceTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *InstanceTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = InstanceTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t InstanceTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t InstanceTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t InstanceTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// AvailabilityZoneTag
+//
+
+func (t AvailabilityZoneTag) encoded() string {
+	if t == (AvailabilityZoneTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *AvailabilityZoneTag) decode(s string) error {
+	if s == "" {
+		*t = AvailabilityZoneTag{}
+		return nil
+	}
+	tag, err := decodeTag[AvailabilityZoneTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t AvailabilityZoneTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *AvailabilityZoneTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t AvailabilityZoneTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *AvailabilityZoneTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t AvailabilityZoneTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *AvailabilityZoneTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t AvailabilityZoneTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *AvailabilityZoneTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t AvailabilityZoneTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *AvailabilityZoneTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t AvailabilityZoneTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *AvailabilityZoneTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t AvailabilityZoneTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *AvailabilityZoneTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = AvailabilityZoneTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t AvailabilityZoneTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t AvailabilityZoneTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t AvailabilityZoneTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// CloudRegionTag
+//
+
+func (t CloudRegionTag) encoded() string {
+	if t == (CloudRegionTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *CloudRegionTag) decode(s string) error {
+	if s == "" {
+		*t = CloudRegionTag{}
+		return nil
+	}
+	tag, err := decodeTag[CloudRegionTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t CloudRegionTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *CloudRegionTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t CloudRegionTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *CloudRegionTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t CloudRegionTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *CloudRegionTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t CloudRegionTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *CloudRegionTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t CloudRegionTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *CloudRegionTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t CloudRegionTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *CloudRegionTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t CloudRegionTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *CloudRegionTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = CloudRegionTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t CloudRegionTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t CloudRegionTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t CloudRegionTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// ImageTag
+//
+
+func (t ImageTag) encoded() string {
+	if t == (ImageTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *ImageTag) decode(s string) error {
+	if s == "" {
+		*t = ImageTag{}
+		return nil
+	}
+	tag, err := decodeTag[ImageTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t ImageTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *ImageTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t ImageTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *ImageTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t ImageTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *ImageTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t ImageTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *ImageTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t ImageTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *ImageTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t ImageTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *ImageTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t ImageTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *ImageTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = ImageTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t ImageTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t ImageTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t ImageTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// SSHKeyTag
+//
+
+func (t SSHKeyTag) encoded() string {
+	if t == (SSHKeyTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *SSHKeyTag) decode(s string) error {
+	if s == "" {
+		*t = SSHKeyTag{}
+		return nil
+	}
+	tag, err := decodeTag[SSHKeyTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t SSHKeyTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *SSHKeyTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t SSHKeyTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *SSHKeyTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t SSHKeyTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *SSHKeyTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t SSHKeyTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *SSHKeyTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t SSHKeyTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *SSHKeyTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t SSHKeyTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *SSHKeyTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t SSHKeyTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *SSHKeyTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = SSHKeyTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t SSHKeyTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t SSHKeyTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t SSHKeyTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// CertificateTag
+//
+
+func (t CertificateTag) encoded() string {
+	if t == (CertificateTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *CertificateTag) decode(s string) error {
+	if s == "" {
+		*t = CertificateTag{}
+		return nil
+	}
+	tag, err := decodeTag[CertificateTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t CertificateTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *CertificateTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t CertificateTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *CertificateTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t CertificateTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *CertificateTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t CertificateTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *CertificateTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t CertificateTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *CertificateTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t CertificateTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *CertificateTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t CertificateTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *CertificateTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = CertificateTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t CertificateTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t CertificateTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t CertificateTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// BackupTag
+//
+
+func (t BackupTag) encoded() string {
+	if t == (BackupTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *BackupTag) decode(s string) error {
+	if s == "" {
+		*t = BackupTag{}
+		return nil
+	}
+	tag, err := decodeTag[BackupTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t BackupTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *BackupTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t BackupTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *BackupTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t BackupTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *BackupTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t BackupTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *BackupTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t BackupTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *BackupTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t BackupTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *BackupTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t BackupTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *BackupTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = BackupTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t BackupTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t BackupTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t BackupTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// UpgradeTag
+//
+
+func (t UpgradeTag) encoded() string {
+	if t == (UpgradeTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *UpgradeTag) decode(s string) error {
+	if s == "" {
+		*t = UpgradeTag{}
+		return nil
+	}
+	tag, err := decodeTag[UpgradeTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t UpgradeTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *UpgradeTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t UpgradeTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *UpgradeTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t UpgradeTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *UpgradeTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t UpgradeTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *UpgradeTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t UpgradeTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *UpgradeTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t UpgradeTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *UpgradeTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t UpgradeTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *UpgradeTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = UpgradeTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t UpgradeTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t UpgradeTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t UpgradeTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// MigrationTag
+//
+
+func (t MigrationTag) encoded() string {
+	if t == (MigrationTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *MigrationTag) decode(s string) error {
+	if s == "" {
+		*t = MigrationTag{}
+		return nil
+	}
+	tag, err := decodeTag[MigrationTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t MigrationTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *MigrationTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t MigrationTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *MigrationTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t MigrationTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *MigrationTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t MigrationTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *MigrationTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t MigrationTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *MigrationTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t MigrationTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *MigrationTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t MigrationTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *MigrationTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = MigrationTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t MigrationTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t MigrationTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t MigrationTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// LeaseTag
+//
+
+func (t LeaseTag) encoded() string {
+	if t == (LeaseTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *LeaseTag) decode(s string) error {
+	if s == "" {
+		*t = LeaseTag{}
+		return nil
+	}
+	tag, err := decodeTag[LeaseTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t LeaseTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *LeaseTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t LeaseTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *LeaseTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t LeaseTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *LeaseTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t LeaseTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *LeaseTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t LeaseTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *LeaseTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t LeaseTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *LeaseTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t LeaseTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *LeaseTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = LeaseTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t LeaseTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t LeaseTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t LeaseTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// AuditEntryTag
+//
+
+func (t AuditEntryTag) encoded() string {
+	if t == (AuditEntryTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *AuditEntryTag) decode(s string) error {
+	if s == "" {
+		*t = AuditEntryTag{}
+		return nil
+	}
+	tag, err := decodeTag[AuditEntryTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t AuditEntryTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *AuditEntryTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t AuditEntryTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *AuditEntryTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t AuditEntryTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *AuditEntryTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t AuditEntryTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *AuditEntryTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t AuditEntryTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *AuditEntryTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t AuditEntryTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *AuditEntryTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t AuditEntryTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *AuditEntryTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = AuditEntryTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t AuditEntryTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t AuditEntryTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t AuditEntryTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// TokenTag
+//
+
+func (t TokenTag) encoded() string {
+	if t == (TokenTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *TokenTag) decode(s string) error {
+	if s == "" {
+		*t = TokenTag{}
+		return nil
+	}
+	tag, err := decodeTag[TokenTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t TokenTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *TokenTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t TokenTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *TokenTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t TokenTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *TokenTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t TokenTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *TokenTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t TokenTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *TokenTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t TokenTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *TokenTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t TokenTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *TokenTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = TokenTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t TokenTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t TokenTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t TokenTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+
+//
+// AgentTag
+//
+
+func (t AgentTag) encoded() string {
+	if t == (AgentTag{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *AgentTag) decode(s string) error {
+	if s == "" {
+		*t = AgentTag{}
+		return nil
+	}
+	tag, err := decodeTag[AgentTag](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t AgentTag) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *AgentTag) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t AgentTag) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *AgentTag) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t AgentTag) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *AgentTag) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t AgentTag) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *AgentTag) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t AgentTag) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *AgentTag) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t AgentTag) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *AgentTag) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t AgentTag) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *AgentTag) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = AgentTag{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t AgentTag) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t AgentTag) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t AgentTag) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
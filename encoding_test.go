@@ -0,0 +1,517 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"strconv"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	gc "gopkg.in/check.v1"
+	goyaml "gopkg.in/yaml.v2"
+
+	"github.com/juju/names"
+)
+
+type encodingSuite struct{}
+
+var _ = gc.Suite(&encodingSuite{})
+
+// encodingTags holds a tag of each concrete type, with an invalid tag
+// string of its kind.
+var encodingTags = []struct {
+	tag     names.Tag
+	invalid string
+}{
+	{names.NewUnitTag("wordpress-site/12"), "unit-mysql"},
+	{names.NewMachineTag("0/lxc/1"), "machine-0-lxc"},
+	{names.NewServiceTag("mysql"), "service-MySQL"},
+	{names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "environment-xyz"},
+	{names.NewUserTag("bob@remote"), "user-@remote"},
+	{names.NewRelationTag("wordpress:db mysql:server"), "relation-wordpress"},
+	{names.NewNetworkTag("net1"), "network-Net1"},
+	{names.NewSpaceTag("db-tier"), "space-1db"},
+	{names.NewSubnetTag("2001:db8::/32"), "subnet-2001:db8::1/32"},
+	{names.NewIPAddressTag("2001:db8::1"), "ipaddress-2001:db8::1/32"},
+	{names.NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "model-xyz"},
+	{names.NewControllerTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "controller-xyz"},
+	{names.NewControllerAgentTag("0"), "controlleragent-01"},
+	{names.NewCloudTag("aws"), "cloud-aws_china"},
+	{names.NewCloudCredentialTag("aws/bob@local/default"), "cloudcred-aws_bob"},
+	{names.NewApplicationTag("wordpress"), "application-wordpress-0"},
+	{names.NewApplicationOfferTag("bob/prod.mysql"), "applicationoffer-mysql-0"},
+	{names.NewRemoteApplicationTag("mysql"), "remoteapplication-mysql-0"},
+	{names.NewRemoteRelationTag("wordpress:db mysql:server"), "remoterelation-wordpress"},
+	{names.NewOperationTag("1"), "operation-01"},
+	{names.NewSecretTag("9m4e2mr0ui3e8a215n4g"), "secret-9m4e2mr0ui3e8a215n4w"},
+	{names.NewSecretBackendTag("vault"), "secretbackend-vault/1"},
+	{names.NewCharmTag("cs:~bob/trusty/wordpress-3"), "charm-wordpress"},
+	{names.NewResourceTag("wordpress/logo"), "resource-wordpress-logo"},
+	{names.NewMetricBatchTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "metricbatch-42"},
+	{names.NewInstanceTag("i-0abc123"), "instance-i 0"},
+	{names.NewAvailabilityZoneTag("us-east-1a"), "zone-us--east"},
+	{names.NewCloudRegionTag("aws/us-east-1"), "cloudregion-aws"},
+	{names.NewImageTag("ami-0abc123"), "image-ami 0"},
+	{names.NewSSHKeyTag("SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8"), "sshkey-16:27"},
+	{names.NewCertificateTag("a1"), "certificate-00a1"},
+	{names.NewBackupTag("20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479"), "backup-20240101-123456"},
+	{names.NewUpgradeTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "upgrade-f47ac10b"},
+	{names.NewMigrationTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "migration-f47ac10b"},
+	{names.NewLeaseTag("application-leadership/mysql"), "lease-application-leadership"},
+	{names.NewAuditEntryTag("42"), "auditentry-042"},
+	{names.NewTokenTag("dGhpcyBpcyBhIHRva2Vu"), "token-abc"},
+	{names.NewActionTag("mysql/0" + names.ActionMarker + "3"), "action-mysql"},
+	{names.NewActionResultTag("mysql/0" + names.ActionResultMarker + "3"), "actionresult-mysql"},
+	{names.NewVolumeTag("mysql/0/3"), "volume-mysql-0"},
+	{names.NewFilesystemTag("0/3"), "filesystem-0-lxc"},
+	{names.NewStorageTag("data/0"), "storage-data"},
+	{names.NewStorageAttachmentTag(names.NewStorageTag("data/0"), names.NewUnitTag("mysql/0")), "storageattachment-mysql-0"},
+	{names.NewVolumeAttachmentTag(names.NewMachineTag("0/lxc/1"), names.NewVolumeTag("0/lxc/1/2")), "volumeattachment-0:0-lxc"},
+	{names.NewFilesystemAttachmentTag(names.NewUnitTag("mysql/0"), names.NewFilesystemTag("mysql/0/2")), "filesystemattachment-mysql:2"},
+	{names.NewFilesystemAttachmentTag(names.NewMachineTag("0"), names.NewFilesystemTag("2")), "filesystemattachment-0:0-lxc"},
+	{mustAgentTag(names.NewMachineTag("1")), "service-mysql"},
+}
+
+func mustAgentTag(entity names.Tag) names.AgentTag {
+	t, err := names.NewAgentTag(entity)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// newTag returns a pointer to a new zero tag of the same type as t.
+func newTag(t names.Tag) interface{} {
+	return reflect.New(reflect.TypeOf(t)).Interface()
+}
+
+// zeroTag returns the zero tag of the same type as t.
+func zeroTag(t names.Tag) interface{} {
+	return reflect.Zero(reflect.TypeOf(t)).Interface()
+}
+
+func (s *encodingSuite) TestJSON(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		data, err := json.Marshal(test.tag)
+		c.Assert(err, gc.IsNil)
+		c.Check(string(data), gc.Equals, `"`+test.tag.String()+`"`)
+		decoded := newTag(test.tag)
+		c.Assert(json.Unmarshal(data, decoded), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, test.tag)
+
+		data, err = json.Marshal(zeroTag(test.tag))
+		c.Assert(err, gc.IsNil)
+		c.Check(string(data), gc.Equals, `""`)
+		c.Assert(json.Unmarshal(data, decoded), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, zeroTag(test.tag))
+
+		err = json.Unmarshal([]byte(`"`+test.invalid+`"`), newTag(test.tag))
+		c.Check(err, gc.ErrorMatches, `"`+test.invalid+`" is not a valid .*tag`)
+		err = json.Unmarshal([]byte(`42`), newTag(test.tag))
+		c.Check(err, gc.NotNil)
+	}
+}
+
+func (s *encodingSuite) TestJSONStruct(c *gc.C) {
+	type params struct {
+		Unit   names.UnitTag      `json:"unit"`
+		Action names.ActionTag    `json:"action"`
+		Owner  *names.UserTag     `json:"owner,omitempty"`
+		Hosts  []names.MachineTag `json:"hosts"`
+	}
+	p := params{
+		Unit:   names.NewUnitTag("mysql/0"),
+		Action: names.NewActionTag("mysql/0" + names.ActionMarker + "1"),
+		Hosts:  []names.MachineTag{names.NewMachineTag("0"), names.NewMachineTag("0/lxc/1")},
+	}
+	data, err := json.Marshal(p)
+	c.Assert(err, gc.IsNil)
+	c.Check(string(data), gc.Equals, `{"unit":"unit-mysql-0","action":"action-mysql/0_a_1","hosts":["machine-0","machine-0-lxc-1"]}`)
+	var decoded params
+	c.Assert(json.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.DeepEquals, p)
+}
+
+func (s *encodingSuite) TestText(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		m, ok := test.tag.(encoding.TextMarshaler)
+		c.Assert(ok, gc.Equals, true)
+		data, err := m.MarshalText()
+		c.Assert(err, gc.IsNil)
+		c.Check(string(data), gc.Equals, test.tag.String())
+
+		decoded := newTag(test.tag)
+		u, ok := decoded.(encoding.TextUnmarshaler)
+		c.Assert(ok, gc.Equals, true)
+		c.Assert(u.UnmarshalText(data), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, test.tag)
+
+		c.Check(u.UnmarshalText([]byte(test.invalid)), gc.ErrorMatches, `"`+test.invalid+`" is not a valid .*tag`)
+		c.Assert(u.UnmarshalText(nil), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, zeroTag(test.tag))
+	}
+}
+
+func (s *encodingSuite) TestTextMapKeys(c *gc.C) {
+	counts := map[names.UnitTag]int{
+		names.NewUnitTag("mysql/0"): 1,
+		names.NewUnitTag("mysql/1"): 2,
+	}
+	data, err := json.Marshal(counts)
+	c.Assert(err, gc.IsNil)
+	c.Check(string(data), gc.Equals, `{"unit-mysql-0":1,"unit-mysql-1":2}`)
+	var decoded map[names.UnitTag]int
+	c.Assert(json.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.DeepEquals, counts)
+
+	err = json.Unmarshal([]byte(`{"unit-mysql":1}`), &decoded)
+	c.Check(err, gc.ErrorMatches, `.*"unit-mysql" is not a valid unit tag`)
+}
+
+func (s *encodingSuite) TestXML(c *gc.C) {
+	type machine struct {
+		Tag  names.MachineTag `xml:"tag,attr"`
+		Unit names.UnitTag    `xml:"unit"`
+	}
+	m := machine{Tag: names.NewMachineTag("0/lxc/1"), Unit: names.NewUnitTag("mysql/0")}
+	data, err := xml.Marshal(m)
+	c.Assert(err, gc.IsNil)
+	c.Check(string(data), gc.Equals, `<machine tag="machine-0-lxc-1"><unit>unit-mysql-0</unit></machine>`)
+	var decoded machine
+	c.Assert(xml.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.Equals, m)
+}
+
+func (s *encodingSuite) TestYAML(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		data, err := goyaml.Marshal(test.tag)
+		c.Assert(err, gc.IsNil)
+		decoded := newTag(test.tag)
+		c.Assert(goyaml.Unmarshal(data, decoded), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, test.tag)
+
+		err = goyaml.Unmarshal([]byte(test.invalid), newTag(test.tag))
+		c.Check(err, gc.ErrorMatches, `"`+test.invalid+`" is not a valid .*tag`)
+	}
+}
+
+func (s *encodingSuite) TestYAMLStruct(c *gc.C) {
+	type config struct {
+		Tag     names.MachineTag `yaml:"tag"`
+		Environ names.EnvironTag `yaml:"environment"`
+		Units   []names.UnitTag  `yaml:"units"`
+		Unset   names.UserTag    `yaml:"unset"`
+	}
+	conf := config{
+		Tag:     names.NewMachineTag("0/lxc/1"),
+		Environ: names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+		Units:   []names.UnitTag{names.NewUnitTag("mysql/0")},
+	}
+	data, err := goyaml.Marshal(conf)
+	c.Assert(err, gc.IsNil)
+	c.Check(string(data), gc.Equals, `tag: machine-0-lxc-1
+environment: environment-f47ac10b-58cc-4372-a567-0e02b2c3d479
+units:
+- unit-mysql-0
+unset: ""
+`)
+	var decoded config
+	c.Assert(goyaml.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.DeepEquals, conf)
+
+	err = goyaml.Unmarshal([]byte("tag: machine-0-lxc\n"), &decoded)
+	c.Check(err, gc.ErrorMatches, `"machine-0-lxc" is not a valid machine tag`)
+}
+
+func (s *encodingSuite) TestGob(c *gc.C) {
+	type message struct {
+		Tag names.Tag
+	}
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		var buf bytes.Buffer
+		c.Assert(gob.NewEncoder(&buf).Encode(message{test.tag}), gc.IsNil)
+		var decoded message
+		c.Assert(gob.NewDecoder(&buf).Decode(&decoded), gc.IsNil)
+		c.Check(decoded.Tag, gc.Equals, test.tag)
+
+		m, ok := test.tag.(gob.GobEncoder)
+		c.Assert(ok, gc.Equals, true)
+		data, err := m.GobEncode()
+		c.Assert(err, gc.IsNil)
+		c.Check(string(data), gc.Equals, test.tag.String())
+
+		u := newTag(test.tag).(gob.GobDecoder)
+		c.Check(u.GobDecode([]byte(test.invalid)), gc.ErrorMatches, `"`+test.invalid+`" is not a valid .*tag`)
+	}
+}
+
+func (s *encodingSuite) TestGobStruct(c *gc.C) {
+	type message struct {
+		Unit    names.UnitTag
+		Machine names.MachineTag
+	}
+	m := message{Unit: names.NewUnitTag("mysql/0"), Machine: names.NewMachineTag("0/lxc/1")}
+	var buf bytes.Buffer
+	c.Assert(gob.NewEncoder(&buf).Encode(m), gc.IsNil)
+	var decoded message
+	c.Assert(gob.NewDecoder(&buf).Decode(&decoded), gc.IsNil)
+	c.Check(decoded, gc.Equals, m)
+}
+
+func (s *encodingSuite) TestCBOR(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		data, err := cbor.Marshal(test.tag)
+		c.Assert(err, gc.IsNil)
+		var raw string
+		c.Assert(cbor.Unmarshal(data, &raw), gc.IsNil)
+		c.Check(raw, gc.Equals, test.tag.String())
+
+		decoded := newTag(test.tag)
+		c.Assert(cbor.Unmarshal(data, decoded), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, test.tag)
+
+		data, err = cbor.Marshal(test.invalid)
+		c.Assert(err, gc.IsNil)
+		err = cbor.Unmarshal(data, newTag(test.tag))
+		c.Check(err, gc.ErrorMatches, `"`+test.invalid+`" is not a valid .*tag`)
+
+		data, err = cbor.Marshal(42)
+		c.Assert(err, gc.IsNil)
+		err = cbor.Unmarshal(data, newTag(test.tag))
+		c.Check(err, gc.ErrorMatches, `cannot decode CBOR tag: not a text string`)
+	}
+}
+
+func (s *encodingSuite) TestCBORLengths(c *gc.C) {
+	for _, n := range []int{1, 15, 16, 247, 248, 65527, 65528} {
+		id := strings.Repeat("a", n)
+		data, err := names.NewServiceTag(id).MarshalCBOR()
+		c.Assert(err, gc.IsNil)
+		var raw string
+		c.Assert(cbor.Unmarshal(data, &raw), gc.IsNil)
+		c.Check(raw, gc.Equals, "service-"+id)
+
+		expect, err := cbor.Marshal("service-" + id)
+		c.Assert(err, gc.IsNil)
+		c.Check(data, gc.DeepEquals, expect)
+	}
+
+	var tag names.UnitTag
+	c.Check(tag.UnmarshalCBOR([]byte{0x6c, 'u', 'n'}), gc.ErrorMatches, `cannot decode CBOR tag: string length 12 does not match data`)
+	c.Check(tag.UnmarshalCBOR([]byte{0x79, 0x00}), gc.ErrorMatches, `cannot decode CBOR tag: unexpected end of data`)
+	c.Check(tag.UnmarshalCBOR([]byte{0x7f, 0x61, 'u', 0xff}), gc.ErrorMatches, `cannot decode CBOR tag: unsupported string length encoding`)
+	c.Check(tag.UnmarshalCBOR([]byte{0xf6}), gc.IsNil)
+	c.Check(tag, gc.Equals, names.UnitTag{})
+}
+
+func (s *encodingSuite) TestCBORStruct(c *gc.C) {
+	type message struct {
+		Unit    names.UnitTag    `cbor:"unit"`
+		Machine names.MachineTag `cbor:"machine"`
+	}
+	m := message{Unit: names.NewUnitTag("mysql/0"), Machine: names.NewMachineTag("0/lxc/1")}
+	data, err := cbor.Marshal(m)
+	c.Assert(err, gc.IsNil)
+	var decoded message
+	c.Assert(cbor.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.Equals, m)
+}
+
+func (s *encodingSuite) TestMsgpack(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		data, err := msgpack.Marshal(test.tag)
+		c.Assert(err, gc.IsNil)
+		var raw string
+		c.Assert(msgpack.Unmarshal(data, &raw), gc.IsNil)
+		c.Check(raw, gc.Equals, test.tag.String())
+
+		decoded := newTag(test.tag)
+		c.Assert(msgpack.Unmarshal(data, decoded), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, test.tag)
+
+		data, err = msgpack.Marshal(test.invalid)
+		c.Assert(err, gc.IsNil)
+		err = msgpack.Unmarshal(data, newTag(test.tag))
+		c.Check(err, gc.ErrorMatches, `"`+test.invalid+`" is not a valid .*tag`)
+
+		data, err = msgpack.Marshal(42)
+		c.Assert(err, gc.IsNil)
+		err = msgpack.Unmarshal(data, newTag(test.tag))
+		c.Check(err, gc.ErrorMatches, `cannot decode MessagePack tag: not a string`)
+	}
+}
+
+func (s *encodingSuite) TestMsgpackLengths(c *gc.C) {
+	for _, n := range []int{1, 23, 24, 247, 248, 65527, 65528} {
+		id := strings.Repeat("a", n)
+		data, err := names.NewServiceTag(id).MarshalMsgpack()
+		c.Assert(err, gc.IsNil)
+		expect, err := msgpack.Marshal("service-" + id)
+		c.Assert(err, gc.IsNil)
+		c.Check(data, gc.DeepEquals, expect)
+
+		var tag names.ServiceTag
+		c.Assert(tag.UnmarshalMsgpack(data), gc.IsNil)
+		c.Check(tag.Id(), gc.Equals, id)
+	}
+
+	var tag names.UnitTag
+	c.Check(tag.UnmarshalMsgpack([]byte{0xac, 'u', 'n'}), gc.ErrorMatches, `cannot decode MessagePack tag: string length 12 does not match data`)
+	c.Check(tag.UnmarshalMsgpack([]byte{0xda, 0x00}), gc.ErrorMatches, `cannot decode MessagePack tag: unexpected end of data`)
+	c.Check(tag.UnmarshalMsgpack(nil), gc.ErrorMatches, `cannot decode MessagePack tag: no data`)
+	c.Check(tag.UnmarshalMsgpack([]byte{0xc0}), gc.IsNil)
+	c.Check(tag, gc.Equals, names.UnitTag{})
+}
+
+func (s *encodingSuite) TestMsgpackStruct(c *gc.C) {
+	type message struct {
+		Unit    names.UnitTag    `msgpack:"unit"`
+		Machine names.MachineTag `msgpack:"machine"`
+	}
+	m := message{Unit: names.NewUnitTag("mysql/0"), Machine: names.NewMachineTag("0/lxc/1")}
+	data, err := msgpack.Marshal(m)
+	c.Assert(err, gc.IsNil)
+	var decoded message
+	c.Assert(msgpack.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.Equals, m)
+}
+
+func (s *encodingSuite) TestBinary(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		m := test.tag.(encoding.BinaryMarshaler)
+		data, err := m.MarshalBinary()
+		c.Assert(err, gc.IsNil)
+		c.Check(len(data) < len(test.tag.String()), gc.Equals, true)
+
+		decoded := newTag(test.tag)
+		u := decoded.(encoding.BinaryUnmarshaler)
+		c.Assert(u.UnmarshalBinary(data), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, test.tag)
+
+		data, err = zeroTag(test.tag).(encoding.BinaryMarshaler).MarshalBinary()
+		c.Assert(err, gc.IsNil)
+		c.Check(data, gc.HasLen, 0)
+		c.Assert(u.UnmarshalBinary(data), gc.IsNil)
+		c.Check(reflect.ValueOf(decoded).Elem().Interface(), gc.Equals, zeroTag(test.tag))
+	}
+}
+
+// binaryKindCodes holds the binary encoding codes of the tag kinds,
+// which must never change.
+var binaryKindCodes = []struct {
+	code byte
+	tag  names.Tag
+}{
+	{1, names.NewUnitTag("mysql/0")},
+	{2, names.NewMachineTag("0/lxc/1")},
+	{3, names.NewServiceTag("mysql")},
+	{4, names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{5, names.NewUserTag("bob@local")},
+	{6, names.NewRelationTag("wordpress:db mysql:server")},
+	{7, names.NewNetworkTag("net1")},
+	{8, names.NewActionTag("mysql/0" + names.ActionMarker + "1")},
+	{9, names.NewActionResultTag("mysql/0" + names.ActionResultMarker + "1")},
+	{10, names.NewVolumeTag("0/1")},
+	{11, names.NewFilesystemTag("0/1")},
+	{12, names.NewStorageTag("data/0")},
+	{13, names.NewStorageAttachmentTag(names.NewStorageTag("data/0"), names.NewUnitTag("mysql/0"))},
+	{14, names.NewVolumeAttachmentTag(names.NewMachineTag("0"), names.NewVolumeTag("0/1"))},
+	{15, names.NewFilesystemAttachmentTag(names.NewUnitTag("mysql/0"), names.NewFilesystemTag("1"))},
+	{16, names.NewSpaceTag("dmz")},
+	{17, names.NewSubnetTag("10.0.0.0/24")},
+	{18, names.NewIPAddressTag("10.0.0.1")},
+	{19, names.NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{20, names.NewControllerTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{21, names.NewControllerAgentTag("0")},
+	{22, names.NewCloudTag("aws")},
+	{23, names.NewCloudCredentialTag("aws/bob@local/default")},
+	{24, names.NewApplicationTag("wordpress")},
+	{25, names.NewApplicationOfferTag("bob/prod.mysql")},
+	{26, names.NewRemoteApplicationTag("mysql")},
+	{27, names.NewRemoteRelationTag("wordpress:db mysql:server")},
+	{28, names.NewOperationTag("1")},
+	{29, names.NewSecretTag("9m4e2mr0ui3e8a215n4g")},
+	{30, names.NewSecretBackendTag("vault")},
+	{31, names.NewCharmTag("cs:~bob/trusty/wordpress-3")},
+	{32, names.NewResourceTag("wordpress/logo")},
+	{33, names.NewMetricBatchTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{34, names.NewInstanceTag("i-0abc123")},
+	{35, names.NewAvailabilityZoneTag("us-east-1a")},
+	{36, names.NewCloudRegionTag("aws/us-east-1")},
+	{37, names.NewImageTag("ami-0abc123")},
+	{38, names.NewSSHKeyTag("16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48")},
+	{39, names.NewCertificateTag("a1")},
+	{40, names.NewBackupTag("20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{41, names.NewUpgradeTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{42, names.NewMigrationTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{43, names.NewLeaseTag("application-leadership/mysql")},
+	{44, names.NewAuditEntryTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")},
+	{45, names.NewTokenTag("dGhpcyBpcyBhIHRva2Vu")},
+}
+
+func (s *encodingSuite) TestBinaryKindCodes(c *gc.C) {
+	for _, test := range binaryKindCodes {
+		data, err := test.tag.(encoding.BinaryMarshaler).MarshalBinary()
+		c.Assert(err, gc.IsNil)
+		c.Check(data, gc.DeepEquals, append([]byte{test.code}, test.tag.Id()...), gc.Commentf("%s", test.tag))
+	}
+}
+
+func (s *encodingSuite) TestBinaryInvalid(c *gc.C) {
+	var unit names.UnitTag
+	err := unit.UnmarshalBinary([]byte("\x01mysql"))
+	c.Check(err, gc.ErrorMatches, `"unit-mysql" is not a valid unit tag`)
+	err = unit.UnmarshalBinary([]byte("\x02mysql/0"))
+	c.Check(err, gc.ErrorMatches, `"machine-mysql-0" is not a valid machine tag`)
+	err = unit.UnmarshalBinary([]byte("\x00mysql/0"))
+	c.Check(err, gc.ErrorMatches, `cannot decode binary tag: unknown kind code 0`)
+	err = unit.UnmarshalBinary([]byte("\x7fmysql/0"))
+	c.Check(err, gc.ErrorMatches, `cannot decode binary tag: unknown kind code 127`)
+	err = unit.UnmarshalBinary([]byte("\x80"))
+	c.Check(err, gc.ErrorMatches, `cannot decode binary tag: invalid kind code`)
+	c.Check(unit, gc.Equals, names.UnitTag{})
+}
+
+type appender interface {
+	AppendString(dst []byte) []byte
+	AppendQuoted(dst []byte) []byte
+}
+
+func (s *encodingSuite) TestAppendString(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %T", i, test.tag)
+		a := test.tag.(appender)
+		c.Check(string(a.AppendString([]byte("tag: "))), gc.Equals, "tag: "+test.tag.String())
+		c.Check(string(a.AppendQuoted([]byte("tag: "))), gc.Equals, "tag: "+strconv.Quote(test.tag.String()))
+	}
+	c.Check(string(names.NewServiceTag(`"ab\c"`).AppendQuoted(nil)), gc.Equals, `"service-\"ab\\c\""`)
+	c.Check(string(names.UserTag{}.AppendString(nil)), gc.Equals, names.UserTag{}.String())
+}
+
+func (s *encodingSuite) TestAppendStringAllocs(c *gc.C) {
+	buf := make([]byte, 0, 256)
+	for i, test := range encodingTags {
+		a := test.tag.(appender)
+		allocs := stdtesting.AllocsPerRun(100, func() {
+			buf = a.AppendString(buf[:0])
+			buf = a.AppendQuoted(buf[:0])
+		})
+		c.Check(allocs, gc.Equals, 0.0, gc.Commentf("test %d: %T", i, test.tag))
+	}
+}
@@ -4,7 +4,9 @@
 package names
 
 import (
-	"regexp"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
 )
 
 const EnvironTagKind = "environment"
@@ -13,8 +15,6 @@ type EnvironTag struct {
 	uuid string
 }
 
-var validUUID = regexp.MustCompile(`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)
-
 // NewEnvironTag returns the tag of an environment with the given environment UUID.
 func NewEnvironTag(uuid string) EnvironTag {
 	return EnvironTag{uuid: uuid}
@@ -37,7 +37,76 @@ func (t EnvironTag) String() string { return t.Kind() + "-" + t.Id() }
 func (t EnvironTag) Kind() string   { return EnvironTagKind }
 func (t EnvironTag) Id() string     { return t.uuid }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t EnvironTag) AppendString(dst []byte) []byte {
+	return append(append(dst, EnvironTagKind+KindSeparator...), t.uuid...)
+}
+
 // IsValidEnvironment returns whether id is a valid environment UUID.
 func IsValidEnvironment(id string) bool {
-	return validUUID.MatchString(id)
+	return matchUUID(id)
+}
+
+// DeriveEnvironTag returns the tag of an environment whose UUID is
+// derived deterministically, as a version 5 (SHA-1 name-based) UUID,
+// from the UUID of its controller, the owner's user id and the
+// environment name. Owner ids are canonicalised first, so "bob" and
+// "bob@local" derive the same tag. It panics if controllerUUID is not
+// a valid UUID, owner is not a valid user id or envName is not a valid
+// environment name.
+func DeriveEnvironTag(controllerUUID, owner, envName string) EnvironTag {
+	return NewEnvironTag(deriveUUID(controllerUUID, owner, envName, "environment"))
+}
+
+// DeriveModelTag returns the tag of a model whose UUID is derived as
+// DeriveEnvironTag derives environment UUIDs, so that a model and the
+// environment of the same name derive the same UUID. It panics if
+// controllerUUID is not a valid UUID, owner is not a valid user id or
+// modelName is not a valid model name. Model names follow the rules
+// for environment names.
+func DeriveModelTag(controllerUUID, owner, modelName string) ModelTag {
+	return NewModelTag(deriveUUID(controllerUUID, owner, modelName, "model"))
+}
+
+// deriveUUID returns the version 5 UUID for the named environment or
+// model, described by noun, owned by owner within the controller.
+func deriveUUID(controllerUUID, owner, name, noun string) string {
+	namespace, ok := parseUUID(controllerUUID)
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid controller UUID", controllerUUID))
+	}
+	if !IsValidUser(owner) {
+		panic(fmt.Sprintf("%q is not a valid user id", owner))
+	}
+	if !matchEnvironName(name) {
+		panic(fmt.Sprintf("%q is not a valid %s name", name, noun))
+	}
+	return newUUIDv5(namespace, NewUserTag(owner).Username()+"/"+name)
+}
+
+// parseUUID returns the 16 bytes of the canonical textual UUID s.
+func parseUUID(s string) ([16]byte, bool) {
+	var uuid [16]byte
+	if len(s) != 36 || !IsValidEnvironment(s) {
+		return uuid, false
+	}
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	if _, err := hex.Decode(uuid[:], []byte(digits)); err != nil {
+		return uuid, false
+	}
+	return uuid, true
+}
+
+// newUUIDv5 returns the textual form of the version 5 UUID for name
+// within namespace, as specified in RFC 4122, section 4.3.
+func newUUIDv5(namespace [16]byte, name string) string {
+	h := sha1.New()
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+	var uuid [16]byte
+	copy(uuid[:], h.Sum(nil))
+	uuid[6] = uuid[6]&0x0f | 0x50
+	uuid[8] = uuid[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
 }
@@ -47,3 +47,33 @@ func (s *environSuite) TestParseEnvironTag(c *gc.C) {
 		c.Check(got, gc.Equals, t.expected)
 	}
 }
+
+func (s *environSuite) TestDeriveEnvironTag(c *gc.C) {
+	const controller = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	tag := names.DeriveEnvironTag(controller, "bob", "prod")
+	c.Check(tag.Id(), gc.Equals, "759c6fe1-8098-5d5e-9f13-0e1fddb2afe6")
+	c.Check(names.IsValidEnvironment(tag.Id()), gc.Equals, true)
+
+	// The owner is canonicalised and the result is stable.
+	c.Check(names.DeriveEnvironTag(controller, "bob@local", "prod"), gc.Equals, tag)
+	c.Check(names.DeriveEnvironTag(controller, "bob@foo", "prod").Id(), gc.Equals, "b45d64ee-d7d5-5986-be5d-0093beb0ea07")
+	c.Check(names.DeriveEnvironTag(controller, "bob", "staging"), gc.Not(gc.Equals), tag)
+
+	c.Check(func() { names.DeriveEnvironTag("not-a-uuid", "bob", "prod") }, gc.PanicMatches, `"not-a-uuid" is not a valid controller UUID`)
+	c.Check(func() { names.DeriveEnvironTag(controller, "b^b", "prod") }, gc.PanicMatches, `"b\^b" is not a valid user id`)
+	c.Check(func() { names.DeriveEnvironTag(controller, "", "prod") }, gc.PanicMatches, `"" is not a valid user id`)
+	c.Check(func() { names.DeriveEnvironTag(controller, "bob", "") }, gc.PanicMatches, `"" is not a valid environment name`)
+	c.Check(func() { names.DeriveEnvironTag(controller, "bob", "Prod") }, gc.PanicMatches, `"Prod" is not a valid environment name`)
+}
+
+func (s *environSuite) TestDeriveModelTag(c *gc.C) {
+	const controller = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	tag := names.DeriveModelTag(controller, "bob", "prod")
+	c.Check(tag, gc.Equals, names.NewModelTag("759c6fe1-8098-5d5e-9f13-0e1fddb2afe6"))
+	c.Check(tag, gc.Equals, names.DeriveEnvironTag(controller, "bob", "prod").ModelTag())
+	c.Check(names.DeriveModelTag(controller, "bob@local", "prod"), gc.Equals, tag)
+
+	c.Check(func() { names.DeriveModelTag("not-a-uuid", "bob", "prod") }, gc.PanicMatches, `"not-a-uuid" is not a valid controller UUID`)
+	c.Check(func() { names.DeriveModelTag(controller, "", "prod") }, gc.PanicMatches, `"" is not a valid user id`)
+	c.Check(func() { names.DeriveModelTag(controller, "bob", "") }, gc.PanicMatches, `"" is not a valid model name`)
+}
@@ -17,14 +17,60 @@ var tagEqualityTests = []struct {
 	{NewServiceTag("ceph"), ServiceTag{Name: "ceph"}},
 	{NewRelationTag("wordpress:haproxy"), RelationTag{key: "wordpress.haproxy"}},
 	{NewEnvironTag("local"), EnvironTag{uuid: "local"}},
+	{NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), ModelTag{uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+	{NewControllerTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), ControllerTag{uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+	{NewControllerAgentTag("0"), ControllerAgentTag{id: "0"}},
+	{NewCloudTag("aws"), CloudTag{name: "aws"}},
+	{NewCloudCredentialTag("aws/bob/default"), CloudCredentialTag{cloud: CloudTag{name: "aws"}, owner: UserTag{name: "bob"}, name: "default"}},
+	{NewApplicationTag("wordpress"), ApplicationTag{name: "wordpress"}},
+	{NewApplicationOfferTag("mysql"), ApplicationOfferTag{id: "mysql"}},
+	{NewRemoteApplicationTag("mysql"), RemoteApplicationTag{name: "mysql"}},
+	{NewRemoteRelationTag("riak:ring"), RemoteRelationTag{key: "riak.ring"}},
+	{NewOperationTag("1"), OperationTag{id: "1"}},
+	{NewSecretTag("9m4e2mr0ui3e8a215n4g"), SecretTag{id: "9m4e2mr0ui3e8a215n4g"}},
+	{NewSecretBackendTag("vault"), SecretBackendTag{id: "vault"}},
+	{NewCharmTag("cs:mysql"), CharmTag{url: "cs:mysql"}},
+	{NewResourceTag("wordpress/logo"), ResourceTag{application: ApplicationTag{name: "wordpress"}, name: "logo"}},
+	{NewMetricBatchTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), MetricBatchTag{uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+	{NewInstanceTag("i-0abc123"), InstanceTag{id: "i-0abc123"}},
+	{NewAvailabilityZoneTag("us-east-1a"), AvailabilityZoneTag{name: "us-east-1a"}},
+	{NewCloudRegionTag("aws/us-east-1"), CloudRegionTag{cloud: CloudTag{name: "aws"}, region: "us-east-1"}},
+	{NewImageTag("ami-0abc123"), ImageTag{id: "ami-0abc123"}},
+	{NewSSHKeyTag("MD5:16:27:AC:A5:76:28:2D:36:63:1B:56:4D:EB:DF:A6:48"), SSHKeyTag{fingerprint: "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48"}},
+	{NewCertificateTag("00A1"), CertificateTag{id: "a1"}},
+	{NewBackupTag("20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479"), BackupTag{id: "20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+	{NewUpgradeTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), UpgradeTag{uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+	{NewMigrationTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), MigrationTag{uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+	{NewLeaseTag("application-leadership/mysql"), LeaseTag{namespace: "application-leadership", name: "mysql"}},
+	{NewAuditEntryTag("42"), AuditEntryTag{id: "42"}},
+	{NewTokenTag("dGhpcyBpcyBhIHRva2Vu"), TokenTag{id: "dGhpcyBpcyBhIHRva2Vu"}},
 	{NewUserTag("admin"), UserTag{name: "admin"}},
 	{NewUserTag("admin@local"), UserTag{name: "admin", provider: "local"}},
 	{NewUserTag("admin@foobar"), UserTag{name: "admin", provider: "foobar"}},
 	{NewNetworkTag("eth0"), NetworkTag{name: "eth0"}},
-	{NewActionTag("foo" + actionMarker + "321"), makeActionTag("foo", "321")},
-	{NewActionTag("foo/0" + actionMarker + "321"), makeActionTag("foo/0", "321")},
-	{NewActionResultTag("foo" + actionResultMarker + "321"), makeActionResultTag("foo", "321")},
-	{NewActionResultTag("foo/0" + actionResultMarker + "321"), makeActionResultTag("foo/0", "321")},
+	{NewSpaceTag("dmz"), SpaceTag{name: "dmz"}},
+	{NewSubnetTag("10.0.0.1/24"), SubnetTag{cidr: "10.0.0.0/24"}},
+	{NewIPAddressTag("::ffff:10.0.0.1"), IPAddressTag{address: "10.0.0.1"}},
+	{NewVolumeTag("0/3"), VolumeTag{id: "0-3"}},
+	{NewVolumeTag("mysql/0/3"), VolumeTag{id: "mysql-0-3"}},
+	{NewFilesystemTag("0/lxc/1/3"), FilesystemTag{id: "0-lxc-1-3"}},
+	{NewStorageTag("shared-fs/0"), StorageTag{id: "shared-fs-0"}},
+	{
+		NewStorageAttachmentTag(NewStorageTag("data/0"), NewUnitTag("mysql/1")),
+		StorageAttachmentTag{unit: UnitTag{name: "mysql-1"}, storage: StorageTag{id: "data-0"}},
+	},
+	{
+		NewVolumeAttachmentTag(NewMachineTag("0/lxc/1"), NewVolumeTag("3")),
+		VolumeAttachmentTag{machine: MachineTag{id: "0-lxc-1"}, volume: VolumeTag{id: "3"}},
+	},
+	{
+		NewFilesystemAttachmentTag(NewUnitTag("mysql/0"), NewFilesystemTag("3")),
+		FilesystemAttachmentTag{host: UnitTag{name: "mysql-0"}, filesystem: FilesystemTag{id: "3"}},
+	},
+	{NewActionTag("foo" + ActionMarker + "321"), makeActionTag("foo", "321")},
+	{NewActionTag("foo/0" + ActionMarker + "321"), makeActionTag("foo/0", "321")},
+	{NewActionResultTag("foo" + ActionResultMarker + "321"), makeActionResultTag("foo", "321")},
+	{NewActionResultTag("foo/0" + ActionResultMarker + "321"), makeActionResultTag("foo/0", "321")},
 }
 
 type equalitySuite struct{}
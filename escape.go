@@ -0,0 +1,60 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// EscapeTag returns the string representation of t with every byte
+// other than the unreserved characters of RFC 3986 (letters, digits,
+// "-", ".", "_" and "~") percent-encoded, so that it may be used as a
+// URL path segment or query parameter value. For example, the action
+// tag "action-mysql/0_a_3" is escaped as "action-mysql%2F0_a_3".
+func EscapeTag(t Tag) string {
+	return escapeTag(t.String(), isUnreserved)
+}
+
+// UnescapeTag parses a tag escaped by EscapeTag. Any percent-encoding
+// is accepted, so that tags escaped by other means can also be parsed.
+func UnescapeTag(s string) (Tag, error) {
+	tag, err := url.PathUnescape(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid escaped tag: %v", s, err)
+	}
+	return ParseTag(tag)
+}
+
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' ||
+		'A' <= c && c <= 'Z' ||
+		'0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// escapeTag returns s with every byte for which safe returns false
+// percent-encoded.
+func escapeTag(s string, safe func(byte) bool) string {
+	const hex = "0123456789ABCDEF"
+	var buf []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if safe(c) {
+			if buf != nil {
+				buf = append(buf, c)
+			}
+			continue
+		}
+		if buf == nil {
+			buf = make([]byte, i, len(s)+2*(len(s)-i))
+			copy(buf, s)
+		}
+		buf = append(buf, '%', hex[c>>4], hex[c&0xf])
+	}
+	if buf == nil {
+		return s
+	}
+	return string(buf)
+}
@@ -0,0 +1,65 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"net/url"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type escapeSuite struct{}
+
+var _ = gc.Suite(&escapeSuite{})
+
+var escapeTests = []struct {
+	tag     names.Tag
+	escaped string
+}{
+	{names.NewUnitTag("mysql/0"), "unit-mysql-0"},
+	{names.NewMachineTag("0/lxc/1"), "machine-0-lxc-1"},
+	{names.NewUserTag("bob@local"), "user-bob%40local"},
+	{names.NewRelationTag("wordpress:db mysql:server"), "relation-wordpress.db%23mysql.server"},
+	{names.JoinActionTag("mysql/0", 3), "action-mysql%2F0_a_3"},
+	{names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "environment-f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+}
+
+func (s *escapeSuite) TestEscapeTag(c *gc.C) {
+	for i, test := range escapeTests {
+		c.Logf("test %d: %s", i, test.tag)
+		c.Check(names.EscapeTag(test.tag), gc.Equals, test.escaped)
+		t, err := names.UnescapeTag(test.escaped)
+		c.Assert(err, gc.IsNil)
+		c.Check(t, gc.Equals, test.tag)
+	}
+}
+
+func (s *escapeSuite) TestRoundTripAllTypes(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %s", i, test.tag)
+		escaped := names.EscapeTag(test.tag)
+		c.Check(escaped, gc.Matches, `([a-zA-Z0-9._~-]|%[0-9A-F]{2})*`)
+		unescaped, err := url.QueryUnescape(escaped)
+		c.Assert(err, gc.IsNil)
+		c.Check(unescaped, gc.Equals, test.tag.String())
+		t, err := names.UnescapeTag(escaped)
+		c.Assert(err, gc.IsNil)
+		c.Check(t.String(), gc.Equals, test.tag.String())
+	}
+}
+
+func (s *escapeSuite) TestUnescapeTagErrors(c *gc.C) {
+	_, err := names.UnescapeTag("action-mysql%2")
+	c.Check(err, gc.ErrorMatches, `"action-mysql%2" is not a valid escaped tag: invalid URL escape "%2"`)
+	_, err = names.UnescapeTag("unit-mysql%200")
+	c.Check(err, gc.ErrorMatches, `"unit-mysql 0" is not a valid unit tag`)
+}
+
+func (s *escapeSuite) TestUnescapeTagAcceptsOtherEscaping(c *gc.C) {
+	t, err := names.UnescapeTag(url.PathEscape("action-mysql/0_a_3"))
+	c.Assert(err, gc.IsNil)
+	c.Check(t, gc.Equals, names.JoinActionTag("mysql/0", 3))
+}
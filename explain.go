@@ -0,0 +1,200 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+// Explain returns a short description, suitable for showing to users,
+// of the rules that ids of the given kind must follow. It returns an
+// empty string for unknown kinds.
+func Explain(kind string) string {
+	switch kind {
+	case ServiceTagKind:
+		return "A service name consists of lower-case letters, digits and hyphens. " +
+			"It must start with a letter, must not end with a hyphen, and each " +
+			"hyphen-separated part must contain at least one letter."
+	case UnitTagKind:
+		return "A unit name is a service name followed by a slash and a unit number, " +
+			"such as \"wordpress/0\". Unit numbers have no leading zeros."
+	case MachineTagKind:
+		depth := "to any depth"
+		if limit := MaxContainerDepth(); limit > 0 {
+			depth = fmt.Sprintf("at most %d levels deep", limit)
+		}
+		return "A machine id is a number, such as \"3\", optionally followed by " +
+			"containers of the form /<type>/<number>, such as \"3/lxc/1\", where " +
+			"the container type consists of lower-case letters. Numbers have no " +
+			"leading zeros, and containers may be nested " + depth + "."
+	case UserTagKind:
+		return "A user name consists of letters, digits, dots and hyphens, must be " +
+			"at least two characters long and must start and end with a letter or " +
+			"digit. It may be followed by @ and the name of an identity provider, " +
+			"which follows the same rules, such as \"bob@local\"."
+	case EnvironTagKind:
+		return "An environment is identified by its UUID, written as 32 lower-case " +
+			"hexadecimal digits in groups of 8-4-4-4-12 separated by hyphens."
+	case ModelTagKind:
+		return "A model is identified by its UUID, written as 32 lower-case " +
+			"hexadecimal digits in groups of 8-4-4-4-12 separated by hyphens."
+	case RelationTagKind:
+		return "A relation key names the endpoints it connects, each written as " +
+			"<service>:<relation>, separated by a space, such as \"wordpress:db " +
+			"mysql:server\"; a peer relation has a single endpoint. Relation names " +
+			"consist of lower-case letters, digits, hyphens and underscores and " +
+			"must start with a letter."
+	case NetworkTagKind:
+		return "A network name consists of lower-case letters and digits, " +
+			"optionally separated by single hyphens."
+	case SpaceTagKind:
+		return "A space name consists of lower-case letters and digits, " +
+			"optionally separated by single hyphens, and must start with a letter."
+	case SubnetTagKind:
+		return "A subnet is identified by its network address and prefix length " +
+			"in CIDR notation, such as \"10.0.0.0/24\" or \"2001:db8::/32\", with " +
+			"IPv6 addresses written in their shortest form."
+	case IPAddressTagKind:
+		return "An IP address is written as an IPv4 address in dotted decimal " +
+			"form, such as \"10.0.0.1\", or an IPv6 address in its shortest form, " +
+			"such as \"2001:db8::1\"."
+	case ControllerTagKind:
+		return "A controller is identified by its UUID, written as 32 lower-case " +
+			"hexadecimal digits in groups of 8-4-4-4-12 separated by hyphens."
+	case ControllerAgentTagKind:
+		return "A controller agent id is a number without leading zeros, such " +
+			"as \"0\"."
+	case CloudTagKind:
+		return "A cloud name consists of letters and digits, optionally separated " +
+			"by single hyphens, such as \"aws-china\"."
+	case CloudCredentialTagKind:
+		return "A cloud credential id is the name of a cloud, the id of the user " +
+			"that owns the credential and the credential name, separated by " +
+			"slashes, such as \"aws/bob@local/default\". Credential names consist " +
+			"of letters, digits, dots, hyphens and underscores and start with a " +
+			"letter or digit."
+	case ApplicationTagKind:
+		return "An application name follows the rules for service names: lower-case " +
+			"letters, digits and hyphens, starting with a letter, not ending with a " +
+			"hyphen, with at least one letter in each hyphen-separated part."
+	case ApplicationOfferTagKind:
+		return "An application offer is identified by its UUID, by its name, which " +
+			"follows the rules for application names, or by its name qualified " +
+			"as <owner>/<environment>.<name>, such as \"bob/prod.mysql\"."
+	case RemoteApplicationTagKind:
+		return "A remote application name is either an application name or, for " +
+			"an application that consumes an offer, \"remote-\" followed by 32 " +
+			"lower-case hexadecimal digits."
+	case RemoteRelationTagKind:
+		return "A remote relation key follows the rules for relation keys: the " +
+			"endpoints it connects, each written as <application>:<relation>, " +
+			"separated by a space, such as \"wordpress:db mysql:server\"."
+	case OperationTagKind:
+		return "An operation id is either a number without leading zeros, such " +
+			"as \"42\", or a UUID, written as 32 lower-case hexadecimal digits in " +
+			"groups of 8-4-4-4-12 separated by hyphens."
+	case SecretTagKind:
+		return "A secret id consists of 20 lower-case base32hex digits (0-9 and " +
+			"a-v), such as \"9m4e2mr0ui3e8a215n4g\"."
+	case SecretBackendTagKind:
+		return "A secret backend is identified by its UUID or by its name, which " +
+			"consists of letters, digits, dots, hyphens and underscores and starts " +
+			"with a letter or digit, such as \"vault\"."
+	case CharmTagKind:
+		return "A charm is identified by its URL, written as " +
+			"<schema>:[~<user>/][<series>/]<name>[-<revision>], such as " +
+			"\"cs:~bob/trusty/wordpress-3\", where the schema is \"cs\" or \"local\" " +
+			"and charm names follow the rules for service names."
+	case ResourceTagKind:
+		return "A resource id is the name of an application and the name of one " +
+			"of its resources, separated by a slash, such as \"wordpress/logo\". " +
+			"Resource names follow the rules for relation names."
+	case MetricBatchTagKind:
+		return "A metric batch is identified by its UUID, written as 32 lower-case " +
+			"hexadecimal digits in groups of 8-4-4-4-12 separated by hyphens."
+	case InstanceTagKind:
+		return fmt.Sprintf("An instance id is chosen by the cloud provider, such as "+
+			"\"i-0abc123\", and may contain any printable ASCII characters other "+
+			"than space, up to %d of them.", MaxInstanceIdLength)
+	case AvailabilityZoneTagKind:
+		return "An availability zone name consists of letters and digits, " +
+			"optionally separated by single hyphens, underscores or dots, " +
+			"such as \"us-east-1a\"."
+	case CloudRegionTagKind:
+		return "A cloud region id is the name of a cloud and the name of one of " +
+			"its regions, separated by a slash, such as \"aws/us-east-1\". " +
+			"Region names follow the rules for availability zone names."
+	case ImageTagKind:
+		return fmt.Sprintf("An image id is chosen by the cloud provider, such as "+
+			"\"ami-0abc123\", and may contain any printable ASCII characters other "+
+			"than space, up to %d of them.", MaxImageIdLength)
+	case SSHKeyTagKind:
+		return "An SSH key id is the key's fingerprint: either 16 lower case " +
+			"hexadecimal bytes separated by colons, as given by MD5, or " +
+			"\"SHA256:\" followed by unpadded base64."
+	case CertificateTagKind:
+		return "A certificate id is either the certificate's SHA-256 fingerprint, " +
+			"as 32 lower case hexadecimal bytes separated by colons, or its " +
+			"serial number, as up to 40 lower case hexadecimal digits without " +
+			"leading zeros."
+	case BackupTagKind:
+		return "A backup id is the UTC time the backup was made, as " +
+			"YYYYMMDD-hhmmss, and a UUID, separated by a dot, such as " +
+			"\"20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479\"."
+	case UpgradeTagKind:
+		return "An upgrade is identified by its UUID, written as 32 lower-case " +
+			"hexadecimal digits in groups of 8-4-4-4-12 separated by hyphens."
+	case MigrationTagKind:
+		return "A migration is identified by its UUID, written as 32 lower-case " +
+			"hexadecimal digits in groups of 8-4-4-4-12 separated by hyphens."
+	case LeaseTagKind:
+		return "A lease id is a namespace and the name of a lease within it, " +
+			"separated by a slash, such as \"application-leadership/mysql\". " +
+			"Namespaces consist of lower case letters and digits, starting " +
+			"with a letter and optionally separated by single hyphens; lease " +
+			"names follow the rules for cloud credential names."
+	case AuditEntryTagKind:
+		return "An audit entry id is either a sequence number without leading " +
+			"zeros, such as \"42\", or a UUID, written as 32 lower-case " +
+			"hexadecimal digits in groups of 8-4-4-4-12 separated by hyphens."
+	case TokenTagKind:
+		return fmt.Sprintf("A token id consists of between %d and %d letters, "+
+			"digits, hyphens and underscores, as in unpadded URL-safe base64.",
+			MinTokenIdLength, MaxTokenIdLength)
+	case ActionTagKind:
+		return "An action id is the name of the unit or service it runs on, " +
+			"followed by " + ActionMarker + " and a sequence number without " +
+			"leading zeros, such as \"wordpress/0" + ActionMarker + "3\"."
+	case ActionResultTagKind:
+		return "An action result id is the name of the unit or service its action " +
+			"ran on, followed by " + ActionResultMarker + " and a sequence number " +
+			"without leading zeros, such as \"wordpress/0" + ActionResultMarker + "3\"."
+	case VolumeTagKind:
+		return "A volume id is a number without leading zeros, such as \"3\", " +
+			"optionally preceded by the id of the machine or the name of the unit " +
+			"that the volume is scoped to and a slash, such as \"0/3\" or \"mysql/0/3\"."
+	case FilesystemTagKind:
+		return "A filesystem id is a number without leading zeros, such as \"3\", " +
+			"optionally preceded by the id of the machine or the name of the unit " +
+			"that the filesystem is scoped to and a slash, such as \"0/3\" or \"mysql/0/3\"."
+	case StorageTagKind:
+		return "A storage instance id is the name of the charm storage, which " +
+			"follows the same rules as a service name, followed by a slash and " +
+			"an ordinal without leading zeros, such as \"data/0\"."
+	case StorageAttachmentTagKind:
+		return "A storage attachment id is the name of a unit and the id of a " +
+			"storage instance attached to it, separated by " + AttachmentSeparator +
+			", such as \"mysql/0" + AttachmentSeparator + "data/0\"."
+	case VolumeAttachmentTagKind:
+		return "A volume attachment id is the id of a machine and the id of a " +
+			"volume attached to it, separated by " + AttachmentSeparator +
+			", such as \"0" + AttachmentSeparator + "0/3\"."
+	case FilesystemAttachmentTagKind:
+		return "A filesystem attachment id is the id of a machine or the name of " +
+			"a unit, and the id of a filesystem attached to it, separated by " +
+			AttachmentSeparator + ", such as \"0" + AttachmentSeparator + "0/3\" or \"mysql/0" +
+			AttachmentSeparator + "3\"."
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type explainSuite struct{}
+
+var _ = gc.Suite(&explainSuite{})
+
+func (s *explainSuite) TestExplainKnownKinds(c *gc.C) {
+	for _, kind := range []string{
+		names.UnitTagKind,
+		names.MachineTagKind,
+		names.ServiceTagKind,
+		names.EnvironTagKind,
+		names.UserTagKind,
+		names.RelationTagKind,
+		names.NetworkTagKind,
+		names.ActionTagKind,
+		names.ActionResultTagKind,
+		names.VolumeTagKind,
+		names.FilesystemTagKind,
+		names.StorageTagKind,
+		names.StorageAttachmentTagKind,
+		names.VolumeAttachmentTagKind,
+		names.FilesystemAttachmentTagKind,
+		names.SpaceTagKind,
+		names.SubnetTagKind,
+		names.IPAddressTagKind,
+		names.ModelTagKind,
+		names.ControllerTagKind,
+		names.ControllerAgentTagKind,
+		names.CloudTagKind,
+		names.CloudCredentialTagKind,
+		names.ApplicationTagKind,
+		names.ApplicationOfferTagKind,
+		names.RemoteApplicationTagKind,
+		names.RemoteRelationTagKind,
+		names.OperationTagKind,
+		names.SecretTagKind,
+		names.SecretBackendTagKind,
+		names.CharmTagKind,
+		names.ResourceTagKind,
+		names.MetricBatchTagKind,
+		names.InstanceTagKind,
+		names.AvailabilityZoneTagKind,
+		names.CloudRegionTagKind,
+		names.ImageTagKind,
+		names.SSHKeyTagKind,
+		names.CertificateTagKind,
+		names.BackupTagKind,
+		names.UpgradeTagKind,
+		names.MigrationTagKind,
+		names.LeaseTagKind,
+		names.AuditEntryTagKind,
+		names.TokenTagKind,
+	} {
+		c.Check(names.Explain(kind), gc.Not(gc.Equals), "", gc.Commentf("kind %q", kind))
+	}
+	c.Check(names.Explain("foo"), gc.Equals, "")
+}
+
+func (s *explainSuite) TestExplainMachineDepth(c *gc.C) {
+	c.Check(names.Explain(names.MachineTagKind), gc.Matches, ".*nested at most 2 levels deep.")
+	defer names.SetMaxContainerDepth(names.SetMaxContainerDepth(3))
+	c.Check(names.Explain(names.MachineTagKind), gc.Matches, ".*nested at most 3 levels deep.")
+	names.SetMaxContainerDepth(0)
+	c.Check(names.Explain(names.MachineTagKind), gc.Matches, ".*nested to any depth.")
+}
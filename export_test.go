@@ -3,8 +3,4 @@
 
 package names
 
-var (
-	ActionMarker       = actionMarker
-	ActionResultMarker = actionResultMarker
-	InvalidTagError    = invalidTagError
-)
+var InvalidTagError = invalidTagError
@@ -0,0 +1,54 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// ToFilePath returns a name derived from t that is safe to use as a
+// file or directory name on all supported platforms, such as an agent's
+// data directory. Characters that are invalid in Windows file names,
+// or are path separators, and upper-case letters, which case-insensitive
+// file systems would not distinguish, are percent-encoded, as is a
+// trailing dot, which Windows strips from file names. For example,
+// the action tag "action-mysql/0_a_3" yields "action-mysql%2F0_a_3".
+// Use TagFromFilePath to recover the tag.
+func ToFilePath(t Tag) string {
+	return filePathName(t.String())
+}
+
+// filePathName returns the file name that ToFilePath derives from the
+// string representation of a tag. Spaces, which Windows also strips
+// from the end of file names, are always escaped.
+func filePathName(tag string) string {
+	name := escapeTag(tag, isFilePathSafe)
+	if strings.HasSuffix(name, ".") {
+		name = name[:len(name)-1] + "%2E"
+	}
+	return name
+}
+
+// TagFromFilePath returns the tag from which ToFilePath derived the
+// final element of path.
+func TagFromFilePath(path string) (Tag, error) {
+	name := filepath.Base(path)
+	tag, err := url.PathUnescape(name)
+	if err != nil || filePathName(tag) != name {
+		return nil, fmt.Errorf("%q is not a valid tag file name", name)
+	}
+	return ParseTag(tag)
+}
+
+// isFilePathSafe reports whether c may appear unescaped in the names
+// returned by ToFilePath. It excludes "%", so that names can be
+// unescaped.
+func isFilePathSafe(c byte) bool {
+	return 'a' <= c && c <= 'z' ||
+		'0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '@'
+}
@@ -0,0 +1,74 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"path/filepath"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type filePathSuite struct{}
+
+var _ = gc.Suite(&filePathSuite{})
+
+var filePathTests = []struct {
+	tag  names.Tag
+	path string
+}{
+	{names.NewUnitTag("mysql/0"), "unit-mysql-0"},
+	{names.NewMachineTag("0/lxc/1"), "machine-0-lxc-1"},
+	{names.NewUserTag("bob@local"), "user-bob@local"},
+	{names.NewUserTag("Bob"), "user-%42ob"},
+	{names.NewRelationTag("wordpress:db mysql:server"), "relation-wordpress.db%23mysql.server"},
+	{names.JoinActionTag("mysql/0", 3), "action-mysql%2F0_a_3"},
+	{names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "environment-f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	// Windows strips a trailing dot from file names.
+	{names.NewInstanceTag("i."), "instance-i%2E"},
+	{names.NewInstanceTag("i.."), "instance-i.%2E"},
+	{names.NewInstanceTag("i.x"), "instance-i.x"},
+}
+
+func (s *filePathSuite) TestToFilePath(c *gc.C) {
+	for i, test := range filePathTests {
+		c.Logf("test %d: %s", i, test.tag)
+		c.Check(names.ToFilePath(test.tag), gc.Equals, test.path)
+		t, err := names.TagFromFilePath(test.path)
+		c.Assert(err, gc.IsNil)
+		c.Check(t, gc.Equals, test.tag)
+	}
+}
+
+func (s *filePathSuite) TestRoundTripAllTypes(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %s", i, test.tag)
+		path := names.ToFilePath(test.tag)
+		c.Check(path, gc.Matches, `([a-z0-9._@-]|%[0-9A-F]{2})*[^.]`)
+		t, err := names.TagFromFilePath(filepath.Join("var", "lib", "juju", path))
+		c.Assert(err, gc.IsNil)
+		c.Check(t.String(), gc.Equals, test.tag.String())
+	}
+}
+
+func (s *filePathSuite) TestTagFromFilePathErrors(c *gc.C) {
+	for i, test := range []struct {
+		path string
+		err  string
+	}{
+		{"action-mysql%2", `"action-mysql%2" is not a valid tag file name`},
+		// Only the encoding made by ToFilePath is accepted.
+		{"user-Bob", `"user-Bob" is not a valid tag file name`},
+		{"unit-mysql%2D0", `"unit-mysql%2D0" is not a valid tag file name`},
+		{"instance-i.", `"instance-i." is not a valid tag file name`},
+		{"instance-i%2E.", `"instance-i%2E." is not a valid tag file name`},
+		{"mysql", `"mysql" is not a valid tag`},
+		{"", `"." is not a valid tag file name`},
+	} {
+		c.Logf("test %d: %q", i, test.path)
+		_, err := names.TagFromFilePath(test.path)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const FilesystemTagKind = "filesystem"
+
+// FilesystemSnippet matches a filesystem id. Filesystem ids have the
+// same form as volume ids, such as "3", "0/3" or "mysql/0/3".
+const FilesystemSnippet = VolumeSnippet
+
+// IsValidFilesystem returns whether id is a valid filesystem id.
+func IsValidFilesystem(id string) bool {
+	return isValidStorageId(id)
+}
+
+type FilesystemTag struct {
+	id string
+}
+
+func (t FilesystemTag) String() string { return t.Kind() + "-" + t.id }
+func (t FilesystemTag) Kind() string   { return FilesystemTagKind }
+func (t FilesystemTag) Id() string     { return volumeTagSuffixToId(t.id) }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t FilesystemTag) AppendString(dst []byte) []byte {
+	return append(append(dst, FilesystemTagKind+KindSeparator...), t.id...)
+}
+
+// Host returns the tag of the machine or unit to which the filesystem
+// is scoped, and whether it is scoped to one.
+func (t FilesystemTag) Host() (Tag, bool) {
+	return volumeHost(t.Id())
+}
+
+// IsHostScoped returns whether the filesystem is scoped to a machine or
+// unit.
+func (t FilesystemTag) IsHostScoped() bool {
+	_, ok := t.Host()
+	return ok
+}
+
+// NewFilesystemTag returns the tag for the filesystem with the given id.
+// It will panic if the given filesystem id is not valid.
+func NewFilesystemTag(id string) FilesystemTag {
+	if !IsValidFilesystem(id) {
+		panic(fmt.Sprintf("%q is not a valid filesystem id", id))
+	}
+	return FilesystemTag{id: strings.Replace(id, "/", KindSeparator, -1)}
+}
+
+// ParseFilesystemTag parses a filesystem tag string.
+func ParseFilesystemTag(filesystemTag string) (FilesystemTag, error) {
+	tag, err := ParseTag(filesystemTag)
+	if err != nil {
+		return FilesystemTag{}, err
+	}
+	ft, ok := tag.(FilesystemTag)
+	if !ok {
+		return FilesystemTag{}, invalidTagError(filesystemTag, FilesystemTagKind)
+	}
+	return ft, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type filesystemSuite struct{}
+
+var _ = gc.Suite(&filesystemSuite{})
+
+var filesystemIdTests = []struct {
+	id    string
+	tag   string
+	valid bool
+	host  names.Tag
+}{
+	{id: "0", tag: "filesystem-0", valid: true},
+	{id: "42", tag: "filesystem-42", valid: true},
+	{id: "0/3", tag: "filesystem-0-3", valid: true, host: names.NewMachineTag("0")},
+	{id: "0/lxc/1/3", tag: "filesystem-0-lxc-1-3", valid: true, host: names.NewMachineTag("0/lxc/1")},
+	{id: "mysql/0/3", tag: "filesystem-mysql-0-3", valid: true, host: names.NewUnitTag("mysql/0")},
+	{id: "my-sql/10/3", tag: "filesystem-my-sql-10-3", valid: true, host: names.NewUnitTag("my-sql/10")},
+	{id: ""},
+	{id: "03"},
+	{id: "-1"},
+	{id: "a"},
+	{id: "mysql/3"},
+	{id: "0/lxc/3"},
+	{id: "0/lxc/1/kvm/2/lxc/3/4"},
+	{id: "0/"},
+	{id: "/3"},
+	{id: "0-3"},
+	{id: "0/03"},
+}
+
+func (s *filesystemSuite) TestFilesystemTag(c *gc.C) {
+	for i, test := range filesystemIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidFilesystem(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid filesystem id", test.id)
+			testFilesystemTag := func() { names.NewFilesystemTag(test.id) }
+			c.Check(testFilesystemTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewFilesystemTag(test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.Kind(), gc.Equals, names.FilesystemTagKind)
+		host, ok := tag.Host()
+		c.Check(ok, gc.Equals, test.host != nil)
+		c.Check(host, gc.Equals, test.host)
+		c.Check(tag.IsHostScoped(), gc.Equals, test.host != nil)
+
+		parsed, err := names.ParseFilesystemTag(test.tag)
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseFilesystemTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "filesystem-0",
+	expected: names.NewFilesystemTag("0"),
+}, {
+	tag:      "filesystem-mysql-0-1",
+	expected: names.NewFilesystemTag("mysql/0/1"),
+}, {
+	tag: "filesystem-mysql-0",
+	err: names.InvalidTagError("filesystem-mysql-0", names.FilesystemTagKind),
+}, {
+	tag: "filesystem-0-lxc-1",
+	err: names.InvalidTagError("filesystem-0-lxc-1", names.FilesystemTagKind),
+}, {
+	tag: "filesystem",
+	err: names.InvalidTagError("filesystem", ""),
+}, {
+	tag: "machine-0",
+	err: names.InvalidTagError("machine-0", names.FilesystemTagKind),
+}}
+
+func (s *filesystemSuite) TestParseFilesystemTag(c *gc.C) {
+	for i, t := range parseFilesystemTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseFilesystemTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
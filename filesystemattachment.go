@@ -0,0 +1,88 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const FilesystemAttachmentTagKind = "filesystemattachment"
+
+// IsValidFilesystemAttachment returns whether id is a valid filesystem
+// attachment id: the id of a machine or the name of a unit, and the id
+// of a filesystem attached to it, separated by AttachmentSeparator,
+// such as "0:0/3" or "mysql/0:3".
+func IsValidFilesystemAttachment(id string) bool {
+	host, filesystem, ok := splitAttachmentId(id)
+	if !ok || !IsValidFilesystem(filesystem) {
+		return false
+	}
+	_, ok = hostTag(host)
+	return ok
+}
+
+// FilesystemAttachmentTag represents the attachment of a filesystem to
+// a machine or unit.
+type FilesystemAttachmentTag struct {
+	// host holds a MachineTag or a UnitTag.
+	host       Tag
+	filesystem FilesystemTag
+}
+
+func (t FilesystemAttachmentTag) String() string { return string(t.AppendString(nil)) }
+func (t FilesystemAttachmentTag) Kind() string   { return FilesystemAttachmentTagKind }
+func (t FilesystemAttachmentTag) Id() string {
+	if t.host == nil {
+		return ""
+	}
+	return t.host.Id() + AttachmentSeparator + t.filesystem.Id()
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t FilesystemAttachmentTag) AppendString(dst []byte) []byte {
+	dst = append(dst, FilesystemAttachmentTagKind+KindSeparator...)
+	switch host := t.host.(type) {
+	case MachineTag:
+		dst = append(dst, host.id...)
+	case UnitTag:
+		dst = append(dst, host.name...)
+	}
+	return append(append(dst, AttachmentSeparator...), t.filesystem.id...)
+}
+
+// Host returns the tag of the machine or unit to which the filesystem
+// is attached.
+func (t FilesystemAttachmentTag) Host() Tag { return t.host }
+
+// Filesystem returns the tag of the attached filesystem.
+func (t FilesystemAttachmentTag) Filesystem() FilesystemTag { return t.filesystem }
+
+// NewFilesystemAttachmentTag returns the tag for the attachment of the
+// given filesystem to the given host, which must be a MachineTag or a
+// UnitTag. It will panic if the host is of any other type, or either
+// tag is the zero value.
+func NewFilesystemAttachmentTag(host Tag, filesystem FilesystemTag) FilesystemAttachmentTag {
+	switch host.(type) {
+	case MachineTag, UnitTag:
+		if host.Id() != "" && filesystem != (FilesystemTag{}) {
+			return FilesystemAttachmentTag{host: host, filesystem: filesystem}
+		}
+	}
+	panic(fmt.Sprintf("cannot attach filesystem %q to %v", filesystem.Id(), host))
+}
+
+// ParseFilesystemAttachmentTag parses a filesystem attachment tag
+// string.
+func ParseFilesystemAttachmentTag(filesystemAttachmentTag string) (FilesystemAttachmentTag, error) {
+	tag, err := ParseTag(filesystemAttachmentTag)
+	if err != nil {
+		return FilesystemAttachmentTag{}, err
+	}
+	ft, ok := tag.(FilesystemAttachmentTag)
+	if !ok {
+		return FilesystemAttachmentTag{}, invalidTagError(filesystemAttachmentTag, FilesystemAttachmentTagKind)
+	}
+	return ft, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type filesystemAttachmentSuite struct{}
+
+var _ = gc.Suite(&filesystemAttachmentSuite{})
+
+var filesystemAttachmentTests = []struct {
+	host       names.Tag
+	filesystem names.FilesystemTag
+	tag        string
+	id         string
+}{{
+	host:       names.NewMachineTag("0/lxc/1"),
+	filesystem: names.NewFilesystemTag("0/lxc/1/3"),
+	tag:        "filesystemattachment-0-lxc-1:0-lxc-1-3",
+	id:         "0/lxc/1:0/lxc/1/3",
+}, {
+	host:       names.NewUnitTag("my-sql/0"),
+	filesystem: names.NewFilesystemTag("3"),
+	tag:        "filesystemattachment-my-sql-0:3",
+	id:         "my-sql/0:3",
+}}
+
+func (s *filesystemAttachmentSuite) TestFilesystemAttachmentTag(c *gc.C) {
+	for i, test := range filesystemAttachmentTests {
+		c.Logf("test %d: %s", i, test.tag)
+		tag := names.NewFilesystemAttachmentTag(test.host, test.filesystem)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Kind(), gc.Equals, names.FilesystemAttachmentTagKind)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.Host(), gc.Equals, test.host)
+		c.Check(tag.Filesystem(), gc.Equals, test.filesystem)
+		c.Check(names.IsValidFilesystemAttachment(test.id), gc.Equals, true)
+
+		parsed, err := names.ParseFilesystemAttachmentTag(test.tag)
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+func (s *filesystemAttachmentSuite) TestNewFilesystemAttachmentTagInvalid(c *gc.C) {
+	c.Check(func() {
+		names.NewFilesystemAttachmentTag(names.NewServiceTag("mysql"), names.NewFilesystemTag("3"))
+	}, gc.PanicMatches, `cannot attach filesystem "3" to service-mysql`)
+	c.Check(func() {
+		names.NewFilesystemAttachmentTag(nil, names.NewFilesystemTag("3"))
+	}, gc.PanicMatches, `cannot attach filesystem "3" to <nil>`)
+	c.Check(func() {
+		names.NewFilesystemAttachmentTag(names.MachineTag{}, names.NewFilesystemTag("3"))
+	}, gc.PanicMatches, `cannot attach filesystem "3" to machine-`)
+	c.Check(func() {
+		names.NewFilesystemAttachmentTag(names.NewMachineTag("0"), names.FilesystemTag{})
+	}, gc.PanicMatches, `cannot attach filesystem "" to machine-0`)
+}
+
+var filesystemAttachmentIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "0:3", valid: true},
+	{id: "mysql/0:mysql/0/3", valid: true},
+	{id: "0"},
+	{id: "mysql:3"},
+	{id: "0:"},
+	{id: ":3"},
+	{id: "0:03"},
+	{id: "mysql/0:3:4"},
+	{id: "0/lxc/1/kvm/2/lxc/3:1"},
+	{id: "0:0/lxc/1/kvm/2/lxc/3/4"},
+}
+
+func (s *filesystemAttachmentSuite) TestIsValidFilesystemAttachment(c *gc.C) {
+	for i, test := range filesystemAttachmentIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidFilesystemAttachment(test.id), gc.Equals, test.valid)
+	}
+}
+
+var parseFilesystemAttachmentTagTests = []struct {
+	tag string
+	err error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag: "filesystemattachment-0",
+	err: names.InvalidTagError("filesystemattachment-0", names.FilesystemAttachmentTagKind),
+}, {
+	tag: "filesystemattachment-mysql:0",
+	err: names.InvalidTagError("filesystemattachment-mysql:0", names.FilesystemAttachmentTagKind),
+}, {
+	tag: "filesystemattachment",
+	err: names.InvalidTagError("filesystemattachment", ""),
+}, {
+	tag: "filesystem-0-3",
+	err: names.InvalidTagError("filesystem-0-3", names.FilesystemAttachmentTagKind),
+}}
+
+func (s *filesystemAttachmentSuite) TestParseFilesystemAttachmentTagErrors(c *gc.C) {
+	for i, t := range parseFilesystemAttachmentTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		_, err := names.ParseFilesystemAttachmentTag(t.tag)
+		c.Check(err, gc.DeepEquals, t.err)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"strings"
+)
+
+// The flag types below implement flag.Value, so that command line tools
+// can accept tags as arguments, validating them as they are parsed:
+//
+//	var machine names.MachineTagFlag
+//	flag.Var(&machine, "machine", "the machine to use")
+//
+// Each flag accepts the string representation of a tag, and its String
+// method returns the empty string until the flag is set. The flag types
+// also implement the Value interface of github.com/spf13/pflag, whose
+// Type method names the kind of value in help text.
+
+// TagFlag holds a tag of any kind given on the command line.
+type TagFlag struct {
+	Tag Tag
+}
+
+// String implements flag.Value.
+func (f *TagFlag) String() string {
+	if f.Tag == nil {
+		return ""
+	}
+	return f.Tag.String()
+}
+
+// Type implements pflag.Value.
+func (f *TagFlag) Type() string { return "tag" }
+
+// Set implements flag.Value.
+func (f *TagFlag) Set(s string) error {
+	t, err := ParseTag(s)
+	if err != nil {
+		return err
+	}
+	f.Tag = t
+	return nil
+}
+
+// MachineTagFlag holds a machine tag given on the command line.
+type MachineTagFlag struct {
+	Tag MachineTag
+}
+
+// String implements flag.Value.
+func (f *MachineTagFlag) String() string { return f.Tag.encoded() }
+
+// Type implements pflag.Value.
+func (f *MachineTagFlag) Type() string { return MachineTagKind }
+
+// Set implements flag.Value.
+func (f *MachineTagFlag) Set(s string) error {
+	t, err := ParseMachineTag(s)
+	if err != nil {
+		return err
+	}
+	f.Tag = t
+	return nil
+}
+
+// UnitTagFlag holds a unit tag given on the command line.
+type UnitTagFlag struct {
+	Tag UnitTag
+}
+
+// String implements flag.Value.
+func (f *UnitTagFlag) String() string { return f.Tag.encoded() }
+
+// Type implements pflag.Value.
+func (f *UnitTagFlag) Type() string { return UnitTagKind }
+
+// Set implements flag.Value.
+func (f *UnitTagFlag) Set(s string) error {
+	t, err := ParseUnitTag(s)
+	if err != nil {
+		return err
+	}
+	f.Tag = t
+	return nil
+}
+
+// ServiceTagFlag holds a service tag given on the command line.
+type ServiceTagFlag struct {
+	Tag ServiceTag
+}
+
+// String implements flag.Value.
+func (f *ServiceTagFlag) String() string { return f.Tag.encoded() }
+
+// Type implements pflag.Value.
+func (f *ServiceTagFlag) Type() string { return ServiceTagKind }
+
+// Set implements flag.Value.
+func (f *ServiceTagFlag) Set(s string) error {
+	t, err := ParseServiceTag(s)
+	if err != nil {
+		return err
+	}
+	f.Tag = t
+	return nil
+}
+
+// UserTagFlag holds a user tag given on the command line.
+type UserTagFlag struct {
+	Tag UserTag
+}
+
+// String implements flag.Value.
+func (f *UserTagFlag) String() string { return f.Tag.encoded() }
+
+// Type implements pflag.Value.
+func (f *UserTagFlag) Type() string { return UserTagKind }
+
+// Set implements flag.Value.
+func (f *UserTagFlag) Set(s string) error {
+	t, err := ParseUserTag(s)
+	if err != nil {
+		return err
+	}
+	f.Tag = t
+	return nil
+}
+
+// EnvironTagFlag holds an environment tag given on the command line.
+type EnvironTagFlag struct {
+	Tag EnvironTag
+}
+
+// String implements flag.Value.
+func (f *EnvironTagFlag) String() string { return f.Tag.encoded() }
+
+// Type implements pflag.Value.
+func (f *EnvironTagFlag) Type() string { return EnvironTagKind }
+
+// Set implements flag.Value.
+func (f *EnvironTagFlag) Set(s string) error {
+	t, err := ParseEnvironTag(s)
+	if err != nil {
+		return err
+	}
+	f.Tag = t
+	return nil
+}
+
+// The slice flag types below accept a comma-separated list of tags, and
+// may be given more than once to accumulate tags. The first time such a
+// flag is set, the list replaces any default value. As well as the
+// pflag.Value interface, they implement pflag.SliceValue.
+
+// TagSliceValue holds a list of tags of any kind given on the command
+// line.
+type TagSliceValue struct {
+	Tags    []Tag
+	changed bool
+}
+
+// String implements flag.Value.
+func (f *TagSliceValue) String() string { return tagListString(f.Tags) }
+
+// Type implements pflag.Value.
+func (f *TagSliceValue) Type() string { return "tagSlice" }
+
+// Set implements flag.Value.
+func (f *TagSliceValue) Set(s string) error {
+	return setTagList(&f.Tags, &f.changed, s, ParseTag)
+}
+
+// Append implements pflag.SliceValue.
+func (f *TagSliceValue) Append(s string) error {
+	return appendTagList(&f.Tags, s, ParseTag)
+}
+
+// Replace implements pflag.SliceValue.
+func (f *TagSliceValue) Replace(vals []string) error {
+	return replaceTagList(&f.Tags, vals, ParseTag)
+}
+
+// GetSlice implements pflag.SliceValue.
+func (f *TagSliceValue) GetSlice() []string { return tagListStrings(f.Tags) }
+
+// MachineTagSliceValue holds a list of machine tags given on the
+// command line.
+type MachineTagSliceValue struct {
+	Tags    []MachineTag
+	changed bool
+}
+
+// String implements flag.Value.
+func (f *MachineTagSliceValue) String() string { return tagListString(f.Tags) }
+
+// Type implements pflag.Value.
+func (f *MachineTagSliceValue) Type() string { return MachineTagKind + "Slice" }
+
+// Set implements flag.Value.
+func (f *MachineTagSliceValue) Set(s string) error {
+	return setTagList(&f.Tags, &f.changed, s, ParseMachineTag)
+}
+
+// Append implements pflag.SliceValue.
+func (f *MachineTagSliceValue) Append(s string) error {
+	return appendTagList(&f.Tags, s, ParseMachineTag)
+}
+
+// Replace implements pflag.SliceValue.
+func (f *MachineTagSliceValue) Replace(vals []string) error {
+	return replaceTagList(&f.Tags, vals, ParseMachineTag)
+}
+
+// GetSlice implements pflag.SliceValue.
+func (f *MachineTagSliceValue) GetSlice() []string { return tagListStrings(f.Tags) }
+
+// UnitTagSliceValue holds a list of unit tags given on the command
+// line.
+type UnitTagSliceValue struct {
+	Tags    []UnitTag
+	changed bool
+}
+
+// String implements flag.Value.
+func (f *UnitTagSliceValue) String() string { return tagListString(f.Tags) }
+
+// Type implements pflag.Value.
+func (f *UnitTagSliceValue) Type() string { return UnitTagKind + "Slice" }
+
+// Set implements flag.Value.
+func (f *UnitTagSliceValue) Set(s string) error {
+	return setTagList(&f.Tags, &f.changed, s, ParseUnitTag)
+}
+
+// Append implements pflag.SliceValue.
+func (f *UnitTagSliceValue) Append(s string) error {
+	return appendTagList(&f.Tags, s, ParseUnitTag)
+}
+
+// Replace implements pflag.SliceValue.
+func (f *UnitTagSliceValue) Replace(vals []string) error {
+	return replaceTagList(&f.Tags, vals, ParseUnitTag)
+}
+
+// GetSlice implements pflag.SliceValue.
+func (f *UnitTagSliceValue) GetSlice() []string { return tagListStrings(f.Tags) }
+
+// parseTagList parses each of vals, returning an error for the first
+// invalid tag.
+func parseTagList[T Tag](vals []string, parse func(string) (T, error)) ([]T, error) {
+	tags := make([]T, 0, len(vals))
+	for _, s := range vals {
+		t, err := parse(s)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, nil
+}
+
+// setTagList parses the comma-separated tags in s, replacing *tags the
+// first time it is called, as recorded in *changed, and appending to
+// them thereafter.
+func setTagList[T Tag](tags *[]T, changed *bool, s string, parse func(string) (T, error)) error {
+	var vals []string
+	if s != "" {
+		// Tags never contain commas.
+		vals = strings.Split(s, ",")
+	}
+	parsed, err := parseTagList(vals, parse)
+	if err != nil {
+		return err
+	}
+	if *changed {
+		*tags = append(*tags, parsed...)
+	} else {
+		*tags = parsed
+		*changed = true
+	}
+	return nil
+}
+
+func appendTagList[T Tag](tags *[]T, s string, parse func(string) (T, error)) error {
+	t, err := parse(s)
+	if err != nil {
+		return err
+	}
+	*tags = append(*tags, t)
+	return nil
+}
+
+func replaceTagList[T Tag](tags *[]T, vals []string, parse func(string) (T, error)) error {
+	parsed, err := parseTagList(vals, parse)
+	if err != nil {
+		return err
+	}
+	*tags = parsed
+	return nil
+}
+
+// tagListStrings returns the string representations of tags.
+func tagListStrings[T Tag](tags []T) []string {
+	vals := make([]string, len(tags))
+	for i, t := range tags {
+		vals[i] = t.String()
+	}
+	return vals
+}
+
+// tagListString formats tags as pflag formats slice values, such as
+// "[unit-mysql-0,unit-mysql-1]".
+func tagListString[T Tag](tags []T) string {
+	return "[" + strings.Join(tagListStrings(tags), ",") + "]"
+}
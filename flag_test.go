@@ -0,0 +1,198 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"flag"
+	"io"
+
+	"github.com/spf13/pflag"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type flagSuite struct{}
+
+var _ = gc.Suite(&flagSuite{})
+
+func (s *flagSuite) newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func (s *flagSuite) TestTagFlag(c *gc.C) {
+	var f names.TagFlag
+	fs := s.newFlagSet()
+	fs.Var(&f, "tag", "")
+	c.Check(f.String(), gc.Equals, "")
+
+	err := fs.Parse([]string{"-tag", "unit-mysql-0"})
+	c.Assert(err, gc.IsNil)
+	c.Check(f.Tag, gc.Equals, names.NewUnitTag("mysql/0"))
+	c.Check(f.String(), gc.Equals, "unit-mysql-0")
+
+	fs = s.newFlagSet()
+	fs.Var(&f, "tag", "")
+	err = fs.Parse([]string{"-tag", "mysql/0"})
+	c.Check(err, gc.ErrorMatches, `invalid value "mysql/0" for flag -tag: "mysql/0" is not a valid tag`)
+}
+
+var typedFlagTests = []struct {
+	value  flag.Value
+	arg    string
+	expect names.Tag
+	err    string
+}{{
+	value:  new(names.MachineTagFlag),
+	arg:    "machine-0-lxc-1",
+	expect: names.NewMachineTag("0/lxc/1"),
+}, {
+	value: new(names.MachineTagFlag),
+	arg:   "unit-mysql-0",
+	err:   `"unit-mysql-0" is not a valid machine tag`,
+}, {
+	value:  new(names.UnitTagFlag),
+	arg:    "unit-mysql-0",
+	expect: names.NewUnitTag("mysql/0"),
+}, {
+	value: new(names.UnitTagFlag),
+	arg:   "mysql/0",
+	err:   `"mysql/0" is not a valid tag`,
+}, {
+	value:  new(names.ServiceTagFlag),
+	arg:    "service-wordpress",
+	expect: names.NewServiceTag("wordpress"),
+}, {
+	value:  new(names.UserTagFlag),
+	arg:    "user-bob@local",
+	expect: names.NewUserTag("bob@local"),
+}, {
+	value:  new(names.EnvironTagFlag),
+	arg:    "environment-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expect: names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	value: new(names.EnvironTagFlag),
+	arg:   "environment-foo",
+	err:   `"environment-foo" is not a valid environment tag`,
+}}
+
+func (s *flagSuite) TestTypedFlags(c *gc.C) {
+	for i, test := range typedFlagTests {
+		c.Logf("test %d: %T %s", i, test.value, test.arg)
+		c.Check(test.value.String(), gc.Equals, "")
+		err := test.value.Set(test.arg)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			c.Check(test.value.String(), gc.Equals, "")
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+		c.Check(test.value.String(), gc.Equals, test.expect.String())
+		c.Check(flagTag(test.value), gc.Equals, test.expect)
+	}
+}
+
+// flagTag returns the tag held by one of the tag flag types.
+func flagTag(v flag.Value) names.Tag {
+	switch v := v.(type) {
+	case *names.MachineTagFlag:
+		return v.Tag
+	case *names.UnitTagFlag:
+		return v.Tag
+	case *names.ServiceTagFlag:
+		return v.Tag
+	case *names.UserTagFlag:
+		return v.Tag
+	case *names.EnvironTagFlag:
+		return v.Tag
+	}
+	panic("unexpected flag type")
+}
+
+var (
+	_ pflag.Value      = (*names.TagFlag)(nil)
+	_ pflag.Value      = (*names.MachineTagFlag)(nil)
+	_ pflag.Value      = (*names.UnitTagFlag)(nil)
+	_ pflag.Value      = (*names.ServiceTagFlag)(nil)
+	_ pflag.Value      = (*names.UserTagFlag)(nil)
+	_ pflag.Value      = (*names.EnvironTagFlag)(nil)
+	_ pflag.SliceValue = (*names.TagSliceValue)(nil)
+	_ pflag.SliceValue = (*names.MachineTagSliceValue)(nil)
+	_ pflag.SliceValue = (*names.UnitTagSliceValue)(nil)
+)
+
+func (s *flagSuite) newPFlagSet() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func (s *flagSuite) TestPFlag(c *gc.C) {
+	var machine names.MachineTagFlag
+	fs := s.newPFlagSet()
+	fs.Var(&machine, "machine", "the machine to use")
+	c.Check(fs.FlagUsages(), gc.Matches, `\s*--machine machine\s+the machine to use\n`)
+
+	err := fs.Parse([]string{"--machine", "machine-2"})
+	c.Assert(err, gc.IsNil)
+	c.Check(machine.Tag, gc.Equals, names.NewMachineTag("2"))
+
+	err = fs.Parse([]string{"--machine", "unit-mysql-0"})
+	c.Check(err, gc.ErrorMatches, `invalid argument "unit-mysql-0" for "--machine" flag: "unit-mysql-0" is not a valid machine tag`)
+}
+
+func (s *flagSuite) TestTagSliceValue(c *gc.C) {
+	units := names.UnitTagSliceValue{
+		Tags: []names.UnitTag{names.NewUnitTag("wordpress/0")},
+	}
+	fs := s.newPFlagSet()
+	fs.Var(&units, "unit", "the units to use")
+	c.Check(fs.FlagUsages(), gc.Matches, `\s*--unit unitSlice\s+the units to use \(default \[unit-wordpress-0\]\)\n`)
+
+	// The first value replaces the default; later values accumulate.
+	err := fs.Parse([]string{"--unit", "unit-mysql-0,unit-mysql-1", "--unit", "unit-mysql-2"})
+	c.Assert(err, gc.IsNil)
+	c.Check(units.Tags, gc.DeepEquals, []names.UnitTag{
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("mysql/1"),
+		names.NewUnitTag("mysql/2"),
+	})
+	c.Check(units.String(), gc.Equals, "[unit-mysql-0,unit-mysql-1,unit-mysql-2]")
+	c.Check(units.GetSlice(), gc.DeepEquals, []string{"unit-mysql-0", "unit-mysql-1", "unit-mysql-2"})
+
+	err = fs.Parse([]string{"--unit", "unit-mysql-3,machine-0"})
+	c.Check(err, gc.ErrorMatches, `invalid argument "unit-mysql-3,machine-0" for "--unit" flag: "machine-0" is not a valid unit tag`)
+	c.Check(units.Tags, gc.HasLen, 3)
+}
+
+func (s *flagSuite) TestTagSliceValueAppendReplace(c *gc.C) {
+	var tags names.TagSliceValue
+	c.Check(tags.String(), gc.Equals, "[]")
+	c.Check(tags.Type(), gc.Equals, "tagSlice")
+
+	err := tags.Append("machine-0")
+	c.Assert(err, gc.IsNil)
+	err = tags.Append("service-mysql")
+	c.Assert(err, gc.IsNil)
+	c.Check(tags.Tags, gc.DeepEquals, []names.Tag{names.NewMachineTag("0"), names.NewServiceTag("mysql")})
+
+	err = tags.Append("mysql")
+	c.Check(err, gc.ErrorMatches, `"mysql" is not a valid tag`)
+
+	err = tags.Replace([]string{"user-bob"})
+	c.Assert(err, gc.IsNil)
+	c.Check(tags.Tags, gc.DeepEquals, []names.Tag{names.NewUserTag("bob")})
+
+	err = tags.Replace([]string{"user-bob", "bob"})
+	c.Check(err, gc.ErrorMatches, `"bob" is not a valid tag`)
+	c.Check(tags.Tags, gc.DeepEquals, []names.Tag{names.NewUserTag("bob")})
+
+	var machines names.MachineTagSliceValue
+	err = machines.Set("")
+	c.Assert(err, gc.IsNil)
+	c.Check(machines.Tags, gc.HasLen, 0)
+	c.Check(machines.Type(), gc.Equals, "machineSlice")
+}
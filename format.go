@@ -0,0 +1,34 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+// formatTag implements fmt.Formatter for the tag types. The %v and %s
+// verbs format the string representation of t, %q and %#v format it as
+// a double-quoted Go string literal, %k formats its kind and %i its id.
+// Width, precision and flags apply as they do to strings.
+func formatTag(f fmt.State, verb rune, t Tag) {
+	var s string
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && f.Flag('#') {
+			fmt.Fprintf(f, "%q", t.String())
+			return
+		}
+		s, verb = t.String(), 's'
+	case 'q', 'x', 'X':
+		s = t.String()
+	case 'k':
+		s, verb = t.Kind(), 's'
+	case 'i':
+		s, verb = t.Id(), 's'
+	default:
+		fmt.Fprintf(f, "%%!%c(%T=%s)", verb, t, t.String())
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s)
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type formatSuite struct{}
+
+var _ = gc.Suite(&formatSuite{})
+
+var formatTests = []struct {
+	format string
+	tag    names.Tag
+	expect string
+}{
+	{"%v", names.NewUnitTag("mysql/0"), "unit-mysql-0"},
+	{"%s", names.NewUnitTag("mysql/0"), "unit-mysql-0"},
+	{"%k", names.NewUnitTag("mysql/0"), "unit"},
+	{"%i", names.NewUnitTag("mysql/0"), "mysql/0"},
+	{"%q", names.NewMachineTag("0/lxc/1"), `"machine-0-lxc-1"`},
+	{"%#v", names.NewMachineTag("0/lxc/1"), `"machine-0-lxc-1"`},
+	{"%i", names.NewMachineTag("0/lxc/1"), "0/lxc/1"},
+	{"%k:%i", names.NewUserTag("bob@local"), "user:bob@local"},
+	{"[%-10k]", names.NewServiceTag("mysql"), "[service   ]"},
+	{"[%8i]", names.NewServiceTag("mysql"), "[   mysql]"},
+	{"%.3k", names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "env"},
+	{"%x", names.NewNetworkTag("eth0"), "6e6574776f726b2d65746830"},
+	{"%i", names.NewRelationTag("wordpress:db mysql:server"), "wordpress:db mysql:server"},
+	{"%i", names.JoinActionTag("mysql/0", 3), "mysql/0_a_3"},
+	{"%k", mustAgentTag(names.NewMachineTag("1")), "machine"},
+	{"%d", names.NewMachineTag("1"), "%!d(names.MachineTag=machine-1)"},
+}
+
+func (s *formatSuite) TestFormat(c *gc.C) {
+	for i, test := range formatTests {
+		c.Logf("test %d: %s %s", i, test.format, test.tag)
+		args := make([]interface{}, strings.Count(test.format, "%"))
+		for j := range args {
+			args[j] = test.tag
+		}
+		c.Check(fmt.Sprintf(test.format, args...), gc.Equals, test.expect)
+	}
+}
+
+func (s *formatSuite) TestFormatAllTypes(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %s", i, test.tag)
+		c.Check(test.tag, gc.Implements, new(fmt.Formatter))
+		c.Check(fmt.Sprintf("%v", test.tag), gc.Equals, test.tag.String())
+		c.Check(fmt.Sprintf("%k-%i", test.tag, test.tag), gc.Equals, test.tag.Kind()+"-"+test.tag.Id())
+	}
+}
+
+func (s *formatSuite) TestLogValue(c *gc.C) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("deployed", "unit", names.NewUnitTag("mysql/0"))
+	logger.Info("deployed", "unit", names.UnitTag{})
+	c.Check(buf.String(), gc.Equals, ""+
+		"level=INFO msg=deployed unit.kind=unit unit.id=mysql/0\n"+
+		"level=INFO msg=deployed\n")
+}
+
+func (s *formatSuite) TestLogValueAllTypes(c *gc.C) {
+	for i, test := range encodingTags {
+		c.Logf("test %d: %s", i, test.tag)
+		c.Assert(test.tag, gc.Implements, new(slog.LogValuer))
+		v := test.tag.(slog.LogValuer).LogValue()
+		c.Check(v.Kind(), gc.Equals, slog.KindGroup)
+		c.Check(v.Group(), gc.DeepEquals, []slog.Attr{
+			slog.String("kind", test.tag.Kind()),
+			slog.String("id", test.tag.Id()),
+		})
+		v = zeroTag(test.tag).(slog.LogValuer).LogValue()
+		c.Check(v.Group(), gc.HasLen, 0)
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+//go:build ignore
+
+// This program generates encoding_gen.go, which holds the methods
+// that every concrete tag type implements in the same way, and
+// encoding_bson_gen.go, which holds the BSON methods built only with
+// the names_bson build tag so that the package does not otherwise
+// depend on the bson package. Run it with go generate after adding a
+// tag type or an encoding.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// tagTypes holds the concrete tag types.
+var tagTypes = []string{
+	"UnitTag",
+	"MachineTag",
+	"ServiceTag",
+	"EnvironTag",
+	"UserTag",
+	"RelationTag",
+	"NetworkTag",
+	"ActionTag",
+	"ActionResultTag",
+	"VolumeTag",
+	"FilesystemTag",
+	"StorageTag",
+	"StorageAttachmentTag",
+	"VolumeAttachmentTag",
+	"FilesystemAttachmentTag",
+	"SpaceTag",
+	"SubnetTag",
+	"IPAddressTag",
+	"ModelTag",
+	"ControllerTag",
+	"ControllerAgentTag",
+	"CloudTag",
+	"CloudCredentialTag",
+	"ApplicationTag",
+	"ApplicationOfferTag",
+	"RemoteApplicationTag",
+	"RemoteRelationTag",
+	"OperationTag",
+	"SecretTag",
+	"SecretBackendTag",
+	"CharmTag",
+	"ResourceTag",
+	"MetricBatchTag",
+	"InstanceTag",
+	"AvailabilityZoneTag",
+	"CloudRegionTag",
+	"ImageTag",
+	"SSHKeyTag",
+	"CertificateTag",
+	"BackupTag",
+	"UpgradeTag",
+	"MigrationTag",
+	"LeaseTag",
+	"AuditEntryTag",
+	"TokenTag",
+	"AgentTag",
+}
+
+// section holds methods generated for every tag type, with the
+// packages they import.
+type section struct {
+	imports []string
+	text    string
+}
+
+var sections = []section{{
+	// encoded and decode convert between a tag and the string held
+	// by each encoding. The zero tag is encoded as the empty string.
+	text: `
+func (t {{.}}) encoded() string {
+	if t == ({{.}}{}) {
+		return ""
+	}
+	return t.String()
+}
+
+func (t *{{.}}) decode(s string) error {
+	if s == "" {
+		*t = {{.}}{}
+		return nil
+	}
+	tag, err := decodeTag[{{.}}](s)
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
+`,
+}, {
+	imports: []string{"encoding/json"},
+	text: `
+// MarshalJSON implements json.Marshaler, encoding the tag as a JSON
+// string holding its string representation.
+func (t {{.}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.encoded())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the tag.
+func (t *{{.}}) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+`,
+}, {
+	text: `
+// MarshalText implements encoding.TextMarshaler, encoding the tag as
+// its string representation.
+func (t {{.}}) MarshalText() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// tag.
+func (t *{{.}}) UnmarshalText(data []byte) error {
+	return t.decode(string(data))
+}
+`,
+}, {
+	text: `
+// MarshalYAML implements yaml.Marshaler, encoding the tag as a string
+// holding its string representation.
+func (t {{.}}) MarshalYAML() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, validating the tag.
+func (t *{{.}}) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+`,
+}, {
+	imports: []string{"encoding/gob"},
+	text: `
+// GobEncode implements gob.GobEncoder, encoding the tag as its string
+// representation so that the encoding does not depend on the layout
+// of the tag type.
+func (t {{.}}) GobEncode() ([]byte, error) {
+	return []byte(t.encoded()), nil
+}
+
+// GobDecode implements gob.GobDecoder, validating the tag.
+func (t *{{.}}) GobDecode(data []byte) error {
+	return t.decode(string(data))
+}
+`,
+}, {
+	text: `
+// MarshalCBOR implements cbor.Marshaler, encoding the tag as a CBOR
+// text string holding its string representation.
+func (t {{.}}) MarshalCBOR() ([]byte, error) {
+	return appendCBORString(nil, t.encoded()), nil
+}
+
+// UnmarshalCBOR implements cbor.Unmarshaler, validating the tag.
+func (t *{{.}}) UnmarshalCBOR(data []byte) error {
+	s, err := cborString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+`,
+}, {
+	text: `
+// MarshalMsgpack implements msgpack.Marshaler, encoding the tag as a
+// MessagePack string holding its string representation.
+func (t {{.}}) MarshalMsgpack() ([]byte, error) {
+	return appendMsgpackString(nil, t.encoded()), nil
+}
+
+// UnmarshalMsgpack implements msgpack.Unmarshaler, validating the tag.
+func (t *{{.}}) UnmarshalMsgpack(data []byte) error {
+	s, err := msgpackString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+`,
+}, {
+	text: `
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the tag
+// compactly as a code identifying its kind followed by its id.
+func (t {{.}}) MarshalBinary() ([]byte, error) {
+	if t.encoded() == "" {
+		return []byte{}, nil
+	}
+	return appendBinaryTag(nil, t.Kind(), t.Id())
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, validating the
+// tag.
+func (t *{{.}}) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*t = {{.}}{}
+		return nil
+	}
+	s, err := binaryTagString(data)
+	if err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+`,
+}, {
+	text: `
+// AppendQuoted appends the string representation of the tag to dst as
+// a double-quoted Go string literal and returns the extended buffer.
+func (t {{.}}) AppendQuoted(dst []byte) []byte {
+	return appendQuotedTag(dst, t)
+}
+`,
+}, {
+	imports: []string{"fmt"},
+	text: `
+// Format implements fmt.Formatter. As well as the usual string verbs,
+// %k formats the tag's kind and %i its id.
+func (t {{.}}) Format(f fmt.State, verb rune) {
+	formatTag(f, verb, t)
+}
+`,
+}, {
+	imports: []string{"log/slog"},
+	text: `
+// LogValue implements slog.LogValuer, logging the tag as a group of its
+// kind and id. The zero value logs as an empty group, which is omitted.
+func (t {{.}}) LogValue() slog.Value {
+	if t.encoded() == "" {
+		return slog.GroupValue()
+	}
+	return slog.GroupValue(
+		slog.String("kind", t.Kind()),
+		slog.String("id", t.Id()),
+	)
+}
+`,
+}}
+
+// bsonSections holds the methods generated for every tag type in
+// encoding_bson_gen.go, under the names_bson build constraint.
+var bsonSections = []section{{
+	imports: []string{"gopkg.in/mgo.v2/bson"},
+	text: `
+// GetBSON implements bson.Getter, storing the tag as a string holding
+// its string representation.
+func (t {{.}}) GetBSON() (interface{}, error) {
+	return t.encoded(), nil
+}
+
+// SetBSON implements bson.Setter, validating the tag.
+func (t *{{.}}) SetBSON(raw bson.Raw) error {
+	var s string
+	if err := raw.Unmarshal(&s); err != nil {
+		return err
+	}
+	return t.decode(s)
+}
+`,
+}}
+
+// initText holds the init function, executed with tagTypes. It
+// registers each tag type with gob, so tags can be sent as Tag
+// interface values.
+const initText = `
+func init() {
+{{- range .}}
+	gob.Register({{.}}{})
+{{- end}}
+}
+`
+
+const header = `// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Code generated by gentags.go; DO NOT EDIT.
+`
+
+func main() {
+	generate("encoding_gen.go", "", sections, initText)
+	generate("encoding_bson_gen.go", "names_bson", bsonSections, "")
+}
+
+// generate writes the given sections, for every tag type, to the named
+// file, built only under the given build constraint if it is not
+// empty, and preceded by prelude if it is not empty.
+func generate(filename, constraint string, sections []section, prelude string) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	if constraint != "" {
+		buf.WriteString("\n//go:build " + constraint + "\n")
+	}
+	buf.WriteString("\npackage names\n")
+	imports := make(map[string]bool)
+	for _, s := range sections {
+		for _, imp := range s.imports {
+			imports[imp] = true
+		}
+	}
+	var paths []string
+	for imp := range imports {
+		paths = append(paths, imp)
+	}
+	// Sort the standard library first.
+	sort.Slice(paths, func(i, j int) bool {
+		if isStd(paths[i]) != isStd(paths[j]) {
+			return isStd(paths[i])
+		}
+		return paths[i] < paths[j]
+	})
+	buf.WriteString("\nimport (\n")
+	for i, imp := range paths {
+		// Separate the standard library from other packages.
+		if i > 0 && isStd(paths[i-1]) != isStd(imp) {
+			buf.WriteString("\n")
+		}
+		buf.WriteString("\t\"" + imp + "\"\n")
+	}
+	buf.WriteString(")\n")
+	if prelude != "" {
+		if err := template.Must(template.New("").Parse(prelude)).Execute(&buf, tagTypes); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, t := range tagTypes {
+		buf.WriteString("\n//\n// " + t + "\n//\n")
+		for _, s := range sections {
+			tmpl := template.Must(template.New("").Parse(s.text))
+			if err := tmpl.Execute(&buf, t); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("cannot format generated code: %v", err)
+	}
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func isStd(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
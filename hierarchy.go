@@ -0,0 +1,180 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parentKinds records, for each kind, the kinds of entity that may
+// directly contain an entity of that kind. Current kinds belong to
+// applications and models; the legacy service and environment kinds
+// form a chain of their own.
+var parentKinds = map[string][]string{
+	ActionTagKind:               {UnitTagKind, ApplicationTagKind},
+	ActionResultTagKind:         {UnitTagKind, ApplicationTagKind},
+	UnitTagKind:                 {ApplicationTagKind},
+	ServiceTagKind:              {EnvironTagKind},
+	MachineTagKind:              {MachineTagKind, ModelTagKind},
+	RelationTagKind:             {ModelTagKind},
+	NetworkTagKind:              {ModelTagKind},
+	SpaceTagKind:                {ModelTagKind},
+	SubnetTagKind:               {SpaceTagKind, ModelTagKind},
+	IPAddressTagKind:            {SubnetTagKind, ModelTagKind},
+	VolumeTagKind:               {MachineTagKind, UnitTagKind, ModelTagKind},
+	FilesystemTagKind:           {MachineTagKind, UnitTagKind, ModelTagKind},
+	StorageTagKind:              {UnitTagKind, ApplicationTagKind},
+	StorageAttachmentTagKind:    {UnitTagKind},
+	VolumeAttachmentTagKind:     {MachineTagKind},
+	FilesystemAttachmentTagKind: {MachineTagKind, UnitTagKind},
+	EnvironTagKind:              nil,
+	ModelTagKind:                nil,
+	UserTagKind:                 nil,
+	TokenTagKind:                {ModelTagKind},
+	AuditEntryTagKind:           {ControllerTagKind},
+	LeaseTagKind:                {ControllerTagKind},
+	MigrationTagKind:            {ModelTagKind},
+	UpgradeTagKind:              {ControllerTagKind},
+	BackupTagKind:               {ControllerTagKind},
+	CertificateTagKind:          {ControllerTagKind},
+	SSHKeyTagKind:               {UserTagKind},
+	ImageTagKind:                {ModelTagKind},
+	CloudRegionTagKind:          {CloudTagKind},
+	AvailabilityZoneTagKind:     {CloudTagKind},
+	InstanceTagKind:             {ModelTagKind},
+	MetricBatchTagKind:          {UnitTagKind},
+	ResourceTagKind:             {ApplicationTagKind},
+	CharmTagKind:                {ModelTagKind},
+	SecretBackendTagKind:        {ControllerTagKind},
+	SecretTagKind:               {ModelTagKind},
+	OperationTagKind:            {ModelTagKind},
+	RemoteRelationTagKind:       {ModelTagKind},
+	RemoteApplicationTagKind:    {ModelTagKind},
+	ApplicationOfferTagKind:     {ModelTagKind},
+	ApplicationTagKind:          {ModelTagKind},
+	CloudCredentialTagKind:      {CloudTagKind},
+	CloudTagKind:                {ControllerTagKind},
+	ControllerAgentTagKind:      {ControllerTagKind},
+	ControllerTagKind:           nil,
+}
+
+// ParentKinds returns the kinds of entity that may directly contain an
+// entity of the given kind: for example, a unit belongs to an
+// application, and a machine is either a container within another
+// machine or belongs directly to a model. Kinds at the top of the
+// hierarchy, and unknown kinds, have no parents.
+func ParentKinds(kind string) []string {
+	parents := parentKinds[kind]
+	if parents == nil {
+		return nil
+	}
+	return append([]string(nil), parents...)
+}
+
+// Ancestors returns the tags of the entities that contain the entity
+// represented by t, nearest first, as far as they can be derived from
+// t's id. For example, the ancestors of the action
+// "wordpress/0_a_1" are the unit "wordpress/0" and the application
+// "wordpress", and the ancestors of the container "0/lxc/1/kvm/2" are
+// the machines "0/lxc/1" and "0". Models are not included, as ids do
+// not record them. The ancestors of an AgentTag are those of its
+// entity.
+func Ancestors(t Tag) ([]Tag, error) {
+	switch t := t.(type) {
+	case AgentTag:
+		if t.Entity() == nil {
+			return nil, fmt.Errorf("agent tag has no entity")
+		}
+		return Ancestors(t.Entity())
+	case UnitTag:
+		if !IsValidUnit(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid unit tag", t.String())
+		}
+		return []Tag{NewApplicationTag(UnitService(t.Id()))}, nil
+	case MachineTag:
+		id := t.Id()
+		if !IsValidMachine(id) {
+			return nil, fmt.Errorf("%q is not a valid machine tag", t.String())
+		}
+		var ancestors []Tag
+		for parts := strings.Split(id, "/"); len(parts) > 1; {
+			parts = parts[:len(parts)-2]
+			ancestors = append(ancestors, NewMachineTag(strings.Join(parts, "/")))
+		}
+		return ancestors, nil
+	case VolumeTag, FilesystemTag:
+		if !IsValidVolume(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid %s tag", t.String(), t.Kind())
+		}
+		host, ok := volumeHost(t.Id())
+		if !ok {
+			return nil, nil
+		}
+		ancestors, err := Ancestors(host)
+		if err != nil {
+			return nil, err
+		}
+		return append([]Tag{host}, ancestors...), nil
+	case StorageAttachmentTag:
+		if !IsValidStorageAttachment(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid storage attachment tag", t.String())
+		}
+		ancestors, err := Ancestors(t.Unit())
+		if err != nil {
+			return nil, err
+		}
+		return append([]Tag{t.Unit()}, ancestors...), nil
+	case VolumeAttachmentTag:
+		if !IsValidVolumeAttachment(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid volume attachment tag", t.String())
+		}
+		ancestors, err := Ancestors(t.Machine())
+		if err != nil {
+			return nil, err
+		}
+		return append([]Tag{t.Machine()}, ancestors...), nil
+	case FilesystemAttachmentTag:
+		if !IsValidFilesystemAttachment(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid filesystem attachment tag", t.String())
+		}
+		ancestors, err := Ancestors(t.Host())
+		if err != nil {
+			return nil, err
+		}
+		return append([]Tag{t.Host()}, ancestors...), nil
+	case CloudCredentialTag:
+		if !IsValidCloudCredential(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid cloud credential tag", t.String())
+		}
+		return []Tag{t.Cloud()}, nil
+	case CloudRegionTag:
+		if !IsValidCloudRegion(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid cloud region tag", t.String())
+		}
+		return []Tag{t.Cloud()}, nil
+	case ResourceTag:
+		if !IsValidResource(t.Id()) {
+			return nil, fmt.Errorf("%q is not a valid resource tag", t.String())
+		}
+		return []Tag{t.Application()}, nil
+	case ActionTag, ActionResultTag:
+		prefix := t.(PrefixTag).PrefixTag()
+		if prefix == nil {
+			return nil, fmt.Errorf("%q has no valid prefix", t.String())
+		}
+		if st, ok := prefix.(ServiceTag); ok {
+			prefix = st.ApplicationTag()
+		}
+		ancestors, err := Ancestors(prefix)
+		if err != nil {
+			return nil, err
+		}
+		return append([]Tag{prefix}, ancestors...), nil
+	}
+	if _, ok := parentKinds[t.Kind()]; !ok {
+		return nil, fmt.Errorf("unknown kind %q", t.Kind())
+	}
+	return nil, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type hierarchySuite struct{}
+
+var _ = gc.Suite(&hierarchySuite{})
+
+func (s *hierarchySuite) TestParentKinds(c *gc.C) {
+	c.Check(names.ParentKinds(names.UnitTagKind), gc.DeepEquals, []string{names.ApplicationTagKind})
+	c.Check(names.ParentKinds(names.ApplicationTagKind), gc.DeepEquals, []string{names.ModelTagKind})
+	c.Check(names.ParentKinds(names.MachineTagKind), gc.DeepEquals, []string{names.MachineTagKind, names.ModelTagKind})
+	c.Check(names.ParentKinds(names.ModelTagKind), gc.IsNil)
+	c.Check(names.ParentKinds(names.ServiceTagKind), gc.DeepEquals, []string{names.EnvironTagKind})
+	c.Check(names.ParentKinds(names.EnvironTagKind), gc.IsNil)
+	c.Check(names.ParentKinds("foo"), gc.IsNil)
+
+	// The result may be modified freely.
+	names.ParentKinds(names.UnitTagKind)[0] = "foo"
+	c.Check(names.ParentKinds(names.UnitTagKind), gc.DeepEquals, []string{names.ApplicationTagKind})
+}
+
+func (s *hierarchySuite) TestParentKindsReachModel(c *gc.C) {
+	// Following the first parent of each current kind reaches a model
+	// rather than a legacy environment.
+	for _, kind := range []string{
+		names.ActionTagKind, names.UnitTagKind, names.MachineTagKind,
+		names.VolumeTagKind, names.IPAddressTagKind,
+	} {
+		c.Logf("kind %q", kind)
+		seen := map[string]bool{}
+		for parents := names.ParentKinds(kind); len(parents) > 0; parents = names.ParentKinds(kind) {
+			seen[kind] = true
+			kind = parents[len(parents)-1]
+			if seen[kind] {
+				break
+			}
+		}
+		c.Check(kind, gc.Equals, names.ModelTagKind)
+	}
+}
+
+type fakeTag struct{}
+
+func (fakeTag) Kind() string   { return "fake" }
+func (fakeTag) Id() string     { return "fake" }
+func (fakeTag) String() string { return "fake-fake" }
+
+var ancestorsTests = []struct {
+	tag    names.Tag
+	expect []names.Tag
+	err    string
+}{{
+	tag:    names.NewResourceTag("wordpress/logo"),
+	expect: []names.Tag{names.NewApplicationTag("wordpress")},
+}, {
+	tag:    names.NewCloudCredentialTag("aws/bob/default"),
+	expect: []names.Tag{names.NewCloudTag("aws")},
+}, {
+	tag:    names.NewCloudRegionTag("aws/us-east-1"),
+	expect: []names.Tag{names.NewCloudTag("aws")},
+}, {
+	tag:    names.NewUnitTag("wordpress/0"),
+	expect: []names.Tag{names.NewApplicationTag("wordpress")},
+}, {
+	tag: names.NewMachineTag("0"),
+}, {
+	tag:    names.NewMachineTag("0/lxc/1/kvm/2"),
+	expect: []names.Tag{names.NewMachineTag("0/lxc/1"), names.NewMachineTag("0")},
+}, {
+	tag:    names.JoinActionTag("wordpress/0", 1),
+	expect: []names.Tag{names.NewUnitTag("wordpress/0"), names.NewApplicationTag("wordpress")},
+}, {
+	tag:    names.NewActionResultTag("wordpress" + names.ActionResultMarker + "1"),
+	expect: []names.Tag{names.NewApplicationTag("wordpress")},
+}, {
+	tag: names.NewVolumeTag("3"),
+}, {
+	tag:    names.NewVolumeTag("0/lxc/1/3"),
+	expect: []names.Tag{names.NewMachineTag("0/lxc/1"), names.NewMachineTag("0")},
+}, {
+	tag:    names.NewVolumeTag("wordpress/0/3"),
+	expect: []names.Tag{names.NewUnitTag("wordpress/0"), names.NewApplicationTag("wordpress")},
+}, {
+	tag:    names.NewFilesystemTag("0/3"),
+	expect: []names.Tag{names.NewMachineTag("0")},
+}, {
+	tag:    names.NewStorageAttachmentTag(names.NewStorageTag("data/0"), names.NewUnitTag("wordpress/0")),
+	expect: []names.Tag{names.NewUnitTag("wordpress/0"), names.NewApplicationTag("wordpress")},
+}, {
+	tag:    names.NewVolumeAttachmentTag(names.NewMachineTag("0/lxc/1"), names.NewVolumeTag("3")),
+	expect: []names.Tag{names.NewMachineTag("0/lxc/1"), names.NewMachineTag("0")},
+}, {
+	tag:    names.NewFilesystemAttachmentTag(names.NewUnitTag("wordpress/0"), names.NewFilesystemTag("3")),
+	expect: []names.Tag{names.NewUnitTag("wordpress/0"), names.NewApplicationTag("wordpress")},
+}, {
+	tag: names.NewServiceTag("wordpress"),
+}, {
+	tag:    mustAgentTag(names.NewUnitTag("wordpress/0")),
+	expect: []names.Tag{names.NewApplicationTag("wordpress")},
+}, {
+	tag:    mustAgentTag(names.NewMachineTag("0/lxc/1")),
+	expect: []names.Tag{names.NewMachineTag("0")},
+}, {
+	tag: names.AgentTag{},
+	err: `agent tag has no entity`,
+}, {
+	tag: names.NewUserTag("bob"),
+}, {
+	tag: names.NewMachineTag("foo"),
+	err: `"machine-foo" is not a valid machine tag`,
+}, {
+	tag: fakeTag{},
+	err: `unknown kind "fake"`,
+}}
+
+func (s *hierarchySuite) TestAncestors(c *gc.C) {
+	for i, test := range ancestorsTests {
+		c.Logf("test %d: %s", i, test.tag)
+		ancestors, err := names.Ancestors(test.tag)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Check(err, gc.IsNil)
+		c.Check(ancestors, gc.DeepEquals, test.expect)
+	}
+}
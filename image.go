@@ -0,0 +1,59 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const ImageTagKind = "image"
+
+// MaxImageIdLength is the maximum length of an image id.
+const MaxImageIdLength = 255
+
+// IsValidImage returns whether id is a valid image id. Image ids are
+// chosen by the cloud provider, such as "ami-0abc123" or
+// "Canonical:UbuntuServer:22.04-LTS:latest", and follow the same rules
+// as instance ids.
+func IsValidImage(id string) bool {
+	return isProviderId(id, MaxImageIdLength)
+}
+
+// ImageTag represents the metadata record of a cloud image, identified
+// by the provider's image id.
+type ImageTag struct {
+	id string
+}
+
+func (t ImageTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ImageTag) Kind() string   { return ImageTagKind }
+func (t ImageTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ImageTag) AppendString(dst []byte) []byte {
+	return append(append(dst, ImageTagKind+KindSeparator...), t.id...)
+}
+
+// NewImageTag returns the tag of the image with the given id. It will
+// panic if the given id is not valid.
+func NewImageTag(id string) ImageTag {
+	if !IsValidImage(id) {
+		panic(fmt.Sprintf("%q is not a valid image id", id))
+	}
+	return ImageTag{id: id}
+}
+
+// ParseImageTag parses an image tag string.
+func ParseImageTag(imageTag string) (ImageTag, error) {
+	tag, err := ParseTag(imageTag)
+	if err != nil {
+		return ImageTag{}, err
+	}
+	it, ok := tag.(ImageTag)
+	if !ok {
+		return ImageTag{}, invalidTagError(imageTag, ImageTagKind)
+	}
+	return it, nil
+}
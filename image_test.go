@@ -0,0 +1,82 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type imageSuite struct{}
+
+var _ = gc.Suite(&imageSuite{})
+
+var imageIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "ami-0abc123", valid: true},
+	{id: "f47ac10b-58cc-4372-a567-0e02b2c3d479", valid: true},
+	{id: "Canonical:UbuntuServer:22.04-LTS:latest", valid: true},
+	{id: "projects/ubuntu-os-cloud/global/images/ubuntu-2204", valid: true},
+	{id: strings.Repeat("a", names.MaxImageIdLength), valid: true},
+	{id: ""},
+	{id: strings.Repeat("a", names.MaxImageIdLength+1)},
+	{id: "ami 0abc123"},
+	{id: "ami-\t0"},
+}
+
+func (s *imageSuite) TestImageTag(c *gc.C) {
+	for i, test := range imageIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidImage(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid image id", test.id)
+			testTag := func() { names.NewImageTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewImageTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		parsed, err := names.ParseImageTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseImageTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "image-ami-0abc123",
+	expected: names.NewImageTag("ami-0abc123"),
+}, {
+	tag: "image-",
+	err: names.InvalidTagError("image-", names.ImageTagKind),
+}, {
+	tag: "instance-ami-0abc123",
+	err: names.InvalidTagError("instance-ami-0abc123", names.ImageTagKind),
+}}
+
+func (s *imageSuite) TestParseImageTag(c *gc.C) {
+	for i, t := range parseImageTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseImageTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const InstanceTagKind = "instance"
+
+// MaxInstanceIdLength is the maximum length of a provider instance id.
+const MaxInstanceIdLength = 255
+
+// IsValidInstance returns whether id is a valid provider instance id.
+// Instance ids are chosen by the cloud provider and are otherwise
+// opaque, so they need only be between 1 and MaxInstanceIdLength bytes
+// long and consist of printable ASCII characters other than space,
+// such as "i-0abc123" or "/MAAS/api/1.0/nodes/node-1/".
+func IsValidInstance(id string) bool {
+	return isProviderId(id, MaxInstanceIdLength)
+}
+
+// isProviderId returns whether id is a valid opaque id chosen by a
+// cloud provider: between 1 and max bytes of printable ASCII other
+// than space.
+func isProviderId(id string, max int) bool {
+	if id == "" || len(id) > max {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// InstanceTag represents a cloud provider instance. It is distinct
+// from the MachineTag of any machine running on the instance.
+type InstanceTag struct {
+	id string
+}
+
+func (t InstanceTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t InstanceTag) Kind() string   { return InstanceTagKind }
+func (t InstanceTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t InstanceTag) AppendString(dst []byte) []byte {
+	return append(append(dst, InstanceTagKind+KindSeparator...), t.id...)
+}
+
+// NewInstanceTag returns the tag of the provider instance with the
+// given id. It will panic if the given id is not valid.
+func NewInstanceTag(id string) InstanceTag {
+	if !IsValidInstance(id) {
+		panic(fmt.Sprintf("%q is not a valid instance id", id))
+	}
+	return InstanceTag{id: id}
+}
+
+// ParseInstanceTag parses an instance tag string.
+func ParseInstanceTag(instanceTag string) (InstanceTag, error) {
+	tag, err := ParseTag(instanceTag)
+	if err != nil {
+		return InstanceTag{}, err
+	}
+	it, ok := tag.(InstanceTag)
+	if !ok {
+		return InstanceTag{}, invalidTagError(instanceTag, InstanceTagKind)
+	}
+	return it, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type instanceSuite struct{}
+
+var _ = gc.Suite(&instanceSuite{})
+
+var instanceIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "i-0abc123", valid: true},
+	{id: "juju-f47ac1-machine-0", valid: true},
+	{id: "/MAAS/api/1.0/nodes/node-1/", valid: true},
+	{id: "projects/p/zones/us-east1-b/instances/x", valid: true},
+	{id: "0", valid: true},
+	{id: strings.Repeat("x", names.MaxInstanceIdLength), valid: true},
+	{id: ""},
+	{id: strings.Repeat("x", names.MaxInstanceIdLength+1)},
+	{id: "i 0abc123"},
+	{id: "i-0abc123\n"},
+	{id: "i-\x000"},
+	{id: "instância"},
+}
+
+func (s *instanceSuite) TestInstanceTag(c *gc.C) {
+	for i, test := range instanceIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidInstance(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid instance id", test.id)
+			testTag := func() { names.NewInstanceTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewInstanceTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		parsed, err := names.ParseInstanceTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseInstanceTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "instance-i-0abc123",
+	expected: names.NewInstanceTag("i-0abc123"),
+}, {
+	tag: "instance-",
+	err: names.InvalidTagError("instance-", names.InstanceTagKind),
+}, {
+	tag: "instance-i 0",
+	err: names.InvalidTagError("instance-i 0", names.InstanceTagKind),
+}, {
+	tag: "machine-0",
+	err: names.InvalidTagError("machine-0", names.InstanceTagKind),
+}}
+
+func (s *instanceSuite) TestParseInstanceTag(c *gc.C) {
+	for i, t := range parseInstanceTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseInstanceTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"net"
+)
+
+const IPAddressTagKind = "ipaddress"
+
+// IsValidIPAddress returns whether address is a valid IPv4 or IPv6
+// address, such as "10.0.0.1" or "2001:db8::1".
+func IsValidIPAddress(address string) bool {
+	return net.ParseIP(address) != nil
+}
+
+// canonicalIPAddress returns the canonical form of address: IPv4
+// addresses, including IPv4-mapped IPv6 addresses, in dotted decimal
+// form and other IPv6 addresses in their shortest form.
+func canonicalIPAddress(address string) (string, bool) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", false
+	}
+	return ip.String(), true
+}
+
+type IPAddressTag struct {
+	address string
+}
+
+func (t IPAddressTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t IPAddressTag) Kind() string   { return IPAddressTagKind }
+func (t IPAddressTag) Id() string     { return t.address }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t IPAddressTag) AppendString(dst []byte) []byte {
+	return append(append(dst, IPAddressTagKind+KindSeparator...), t.address...)
+}
+
+// NewIPAddressTag returns the tag of the given IP address. The tag's id
+// is the canonical form of the address, so that, for example,
+// "2001:DB8:0::1" yields the tag "ipaddress-2001:db8::1". It will panic
+// if the given address is not valid.
+func NewIPAddressTag(address string) IPAddressTag {
+	canonical, ok := canonicalIPAddress(address)
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid IP address", address))
+	}
+	return IPAddressTag{address: canonical}
+}
+
+// ParseIPAddressTag parses an IP address tag string. Only tags holding
+// the canonical form of an address are valid.
+func ParseIPAddressTag(ipAddressTag string) (IPAddressTag, error) {
+	tag, err := ParseTag(ipAddressTag)
+	if err != nil {
+		return IPAddressTag{}, err
+	}
+	it, ok := tag.(IPAddressTag)
+	if !ok {
+		return IPAddressTag{}, invalidTagError(ipAddressTag, IPAddressTagKind)
+	}
+	return it, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type ipAddressSuite struct{}
+
+var _ = gc.Suite(&ipAddressSuite{})
+
+var ipAddressTests = []struct {
+	address string
+	valid   bool
+	id      string
+}{
+	{address: "10.0.0.1", valid: true, id: "10.0.0.1"},
+	{address: "0.0.0.0", valid: true, id: "0.0.0.0"},
+	{address: "::ffff:192.168.1.1", valid: true, id: "192.168.1.1"},
+	{address: "2001:db8::1", valid: true, id: "2001:db8::1"},
+	{address: "2001:DB8:0:0::1", valid: true, id: "2001:db8::1"},
+	{address: "::1", valid: true, id: "::1"},
+	{address: ""},
+	{address: "10.0.0"},
+	{address: "10.0.0.256"},
+	{address: "010.0.0.1"},
+	{address: "10.0.0.0/24"},
+	{address: "fe80::1%eth0"},
+	{address: "2001:db8::g"},
+	{address: "localhost"},
+}
+
+func (s *ipAddressSuite) TestIPAddressTag(c *gc.C) {
+	for i, test := range ipAddressTests {
+		c.Logf("test %d: %q", i, test.address)
+		c.Check(names.IsValidIPAddress(test.address), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid IP address", test.address)
+			testIPAddressTag := func() { names.NewIPAddressTag(test.address) }
+			c.Check(testIPAddressTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewIPAddressTag(test.address)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, names.IPAddressTagKind+"-"+test.id)
+		parsed, err := names.ParseIPAddressTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseIPAddressTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "ipaddress-10.0.0.1",
+	expected: names.NewIPAddressTag("10.0.0.1"),
+}, {
+	tag: "ipaddress-::ffff:10.0.0.1",
+	err: names.InvalidTagError("ipaddress-::ffff:10.0.0.1", names.IPAddressTagKind),
+}, {
+	tag: "ipaddress",
+	err: names.InvalidTagError("ipaddress", ""),
+}, {
+	tag: "subnet-10.0.0.0/24",
+	err: names.InvalidTagError("subnet-10.0.0.0/24", names.IPAddressTagKind),
+}}
+
+func (s *ipAddressSuite) TestParseIPAddressTag(c *gc.C) {
+	for i, t := range parseIPAddressTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseIPAddressTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
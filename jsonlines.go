@@ -0,0 +1,95 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteTagLines writes tags to w in JSON Lines format: one JSON
+// string holding the string representation of a tag per line. Output
+// may be appended to an existing list.
+func WriteTagLines(w io.Writer, tags []Tag) error {
+	bw := bufio.NewWriter(w)
+	for _, t := range tags {
+		data, err := json.Marshal(t.String())
+		if err != nil {
+			return err
+		}
+		if _, err := bw.Write(data); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// TagLineReader reads tags from a list in JSON Lines format, as
+// written by WriteTagLines. Blank lines are ignored.
+type TagLineReader struct {
+	scanner *bufio.Scanner
+	line    int
+	tag     Tag
+	err     error
+}
+
+// ReadTagLines returns a reader for the tags in r. Use it like a
+// bufio.Scanner:
+//
+//	tags := names.ReadTagLines(r)
+//	for tags.Next() {
+//		use(tags.Tag())
+//	}
+//	if err := tags.Err(); err != nil {
+//		...
+//	}
+func ReadTagLines(r io.Reader) *TagLineReader {
+	return &TagLineReader{scanner: bufio.NewScanner(r)}
+}
+
+// Next advances to the next tag, returning false when there are no
+// more tags or an error occurs.
+func (r *TagLineReader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	for r.scanner.Scan() {
+		r.line++
+		data := bytes.TrimSpace(r.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			r.err = fmt.Errorf("line %d: %v", r.line, err)
+			return false
+		}
+		tag, err := ParseTag(s)
+		if err != nil {
+			r.err = fmt.Errorf("line %d: %v", r.line, err)
+			return false
+		}
+		r.tag = tag
+		return true
+	}
+	r.err = r.scanner.Err()
+	return false
+}
+
+// Tag returns the tag read by the most recent call to Next.
+func (r *TagLineReader) Tag() Tag { return r.tag }
+
+// Line returns the number of lines consumed so far. After a failed
+// read it holds the number of the offending line, so that a resumed
+// read can skip the lines already processed.
+func (r *TagLineReader) Line() int { return r.line }
+
+// Err returns the first error encountered by Next, if any.
+func (r *TagLineReader) Err() error { return r.err }
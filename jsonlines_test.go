@@ -0,0 +1,86 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type jsonLinesSuite struct{}
+
+var _ = gc.Suite(&jsonLinesSuite{})
+
+func (s *jsonLinesSuite) TestRoundTrip(c *gc.C) {
+	tags := []names.Tag{
+		names.NewUnitTag("mysql/0"),
+		names.NewRelationTag("wordpress:db mysql:server"),
+		names.NewUserTag("bob@local"),
+	}
+	var buf bytes.Buffer
+	c.Assert(names.WriteTagLines(&buf, tags[:2]), gc.IsNil)
+	c.Assert(names.WriteTagLines(&buf, tags[2:]), gc.IsNil)
+	c.Check(buf.String(), gc.Equals, `"unit-mysql-0"
+"relation-wordpress.db#mysql.server"
+"user-bob@local"
+`)
+
+	var got []names.Tag
+	r := names.ReadTagLines(&buf)
+	for r.Next() {
+		got = append(got, r.Tag())
+	}
+	c.Check(r.Err(), gc.IsNil)
+	c.Check(got, gc.DeepEquals, tags)
+	c.Check(r.Line(), gc.Equals, 3)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func (s *jsonLinesSuite) TestWriteErrors(c *gc.C) {
+	short := []names.Tag{names.NewUnitTag("mysql/0")}
+	c.Check(names.WriteTagLines(failingWriter{}, short), gc.ErrorMatches, "write failed")
+
+	// A tag longer than the write buffer fails while it is written,
+	// before the final flush.
+	long := []names.Tag{names.NewUnitTag(strings.Repeat("a", 8192) + "/0"), names.NewUnitTag("mysql/0")}
+	c.Check(names.WriteTagLines(failingWriter{}, long), gc.ErrorMatches, "write failed")
+}
+
+func (s *jsonLinesSuite) TestReadErrors(c *gc.C) {
+	for i, test := range []struct {
+		input string
+		read  int
+		err   string
+		line  int
+	}{
+		{"\"machine-0\"\n\n  \"machine-1\"  \n", 2, "", 3},
+		{"\"machine-0\"\nmachine-1\n", 1, "line 2: invalid character 'm' .*", 2},
+		{"\"machine-0\"\n\"unit-mysql\"\n\"machine-2\"\n", 1, `line 2: "unit-mysql" is not a valid unit tag`, 2},
+	} {
+		c.Logf("test %d: %q", i, test.input)
+		r := names.ReadTagLines(strings.NewReader(test.input))
+		read := 0
+		for r.Next() {
+			read++
+		}
+		c.Check(read, gc.Equals, test.read)
+		c.Check(r.Line(), gc.Equals, test.line)
+		if test.err == "" {
+			c.Check(r.Err(), gc.IsNil)
+		} else {
+			c.Check(r.Err(), gc.ErrorMatches, test.err)
+			c.Check(r.Next(), gc.Equals, false)
+		}
+	}
+}
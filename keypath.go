@@ -0,0 +1,43 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// KeyPath returns the canonical key-value store path for the entity
+// represented by t under the given prefix. The path is made of the
+// prefix, the tag's kind and its id as separate segments, with the id
+// escaped so that it always forms a single segment; for example,
+// KeyPath("/juju", names.NewUnitTag("mysql/0")) returns
+// "/juju/unit/mysql%2F0".
+func KeyPath(prefix string, t Tag) string {
+	return strings.TrimSuffix(prefix, "/") + "/" + t.Kind() + "/" + url.PathEscape(t.Id())
+}
+
+// TagFromKeyPath returns the tag for the entity stored at the given
+// key path under prefix, as created by KeyPath.
+func TagFromKeyPath(prefix, path string) (Tag, error) {
+	prefix = strings.TrimSuffix(prefix, "/") + "/"
+	if !strings.HasPrefix(path, prefix) {
+		return nil, fmt.Errorf("key path %q is not under %q", path, prefix)
+	}
+	parts := strings.Split(path[len(prefix):], "/")
+	if len(parts) != 2 || !validKinds(parts[0]) {
+		return nil, fmt.Errorf("%q is not a valid tag key path", path)
+	}
+	kind := parts[0]
+	id, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid tag key path", path)
+	}
+	t, err := TagFromKindId(kind, id)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid %s key path", path, kind)
+	}
+	return t, nil
+}
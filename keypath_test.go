@@ -0,0 +1,60 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type keyPathSuite struct{}
+
+var _ = gc.Suite(&keyPathSuite{})
+
+var keyPathTests = []struct {
+	tag  names.Tag
+	path string
+}{
+	{names.NewUnitTag("mysql/0"), "/juju/unit/mysql%2F0"},
+	{names.NewUnitTag("rabbitmq-server/12"), "/juju/unit/rabbitmq-server%2F12"},
+	{names.NewMachineTag("0/lxc/1"), "/juju/machine/0%2Flxc%2F1"},
+	{names.NewServiceTag("wordpress"), "/juju/service/wordpress"},
+	{names.NewUserTag("bob@local"), "/juju/user/bob@local"},
+	{names.NewRelationTag("wordpress:db mysql:server"), "/juju/relation/wordpress:db%20mysql:server"},
+	{names.NewRelationTag("riak:ring"), "/juju/relation/riak:ring"},
+	{names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"), "/juju/environment/f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	{names.JoinActionTag("mysql/0", 3), "/juju/action/mysql%2F0_a_3"},
+}
+
+func (s *keyPathSuite) TestKeyPath(c *gc.C) {
+	for i, test := range keyPathTests {
+		c.Logf("test %d: %s", i, test.tag)
+		c.Check(names.KeyPath("/juju", test.tag), gc.Equals, test.path)
+		c.Check(names.KeyPath("/juju/", test.tag), gc.Equals, test.path)
+		tag, err := names.TagFromKeyPath("/juju", test.path)
+		c.Check(err, gc.IsNil)
+		c.Check(tag, gc.Equals, test.tag)
+	}
+	c.Check(names.KeyPath("", names.NewMachineTag("1")), gc.Equals, "/machine/1")
+}
+
+func (s *keyPathSuite) TestTagFromKeyPathErrors(c *gc.C) {
+	for i, test := range []struct {
+		path string
+		err  string
+	}{
+		{"/other/unit/mysql%2F0", `key path "/other/unit/mysql%2F0" is not under "/juju/"`},
+		{"/juju/unit/mysql/0", `"/juju/unit/mysql/0" is not a valid tag key path`},
+		{"/juju/foo/bar", `"/juju/foo/bar" is not a valid tag key path`},
+		{"/juju/unit/mysql%zz", `"/juju/unit/mysql%zz" is not a valid tag key path`},
+		{"/juju/unit/mysql", `"/juju/unit/mysql" is not a valid unit key path`},
+		{"/juju/unit/mysql-0", `"/juju/unit/mysql-0" is not a valid unit key path`},
+		{"/juju/machine/0-lxc-1", `"/juju/machine/0-lxc-1" is not a valid machine key path`},
+	} {
+		c.Logf("test %d: %s", i, test.path)
+		_, err := names.TagFromKeyPath("/juju", test.path)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const LeaseTagKind = "lease"
+
+// LeaseNamespaceSnippet matches a lease namespace: lower case letters
+// and digits, starting with a letter and optionally separated by single
+// hyphens, such as "application-leadership".
+const LeaseNamespaceSnippet = "(?:[a-z][a-z0-9]*(?:-[a-z0-9]+)*)"
+
+// LeaseNameSnippet matches the name of a lease within its namespace,
+// such as the name of an application or the UUID of a model. Lease
+// names follow the same rules as cloud credential names.
+const LeaseNameSnippet = CloudCredentialNameSnippet
+
+// leaseTagSeparator separates the namespace and name in the string
+// representation of a lease tag. Namespaces never contain it.
+const leaseTagSeparator = "_"
+
+// IsValidLeaseNamespace returns whether namespace is a valid lease
+// namespace.
+func IsValidLeaseNamespace(namespace string) bool {
+	return matchLeaseNamespace(namespace)
+}
+
+// IsValidLeaseName returns whether name is a valid lease name.
+func IsValidLeaseName(name string) bool {
+	return matchCloudCredentialName(name)
+}
+
+// IsValidLease returns whether id is a valid lease id: a namespace and
+// the name of a lease within it, separated by a slash, such as
+// "application-leadership/mysql" or
+// "singular-controller/f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func IsValidLease(id string) bool {
+	_, ok := splitLeaseId(id, "/")
+	return ok
+}
+
+// LeaseTag represents a lease, such as the leadership of an
+// application or the right of a controller to run singular workers.
+type LeaseTag struct {
+	namespace string
+	name      string
+}
+
+func (t LeaseTag) String() string { return string(t.AppendString(nil)) }
+func (t LeaseTag) Kind() string   { return LeaseTagKind }
+func (t LeaseTag) Id() string {
+	if t == (LeaseTag{}) {
+		return ""
+	}
+	return t.namespace + "/" + t.name
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t LeaseTag) AppendString(dst []byte) []byte {
+	dst = append(dst, LeaseTagKind+KindSeparator...)
+	if t == (LeaseTag{}) {
+		return dst
+	}
+	dst = append(append(dst, t.namespace...), leaseTagSeparator...)
+	return append(dst, t.name...)
+}
+
+// Namespace returns the namespace the lease belongs to.
+func (t LeaseTag) Namespace() string { return t.namespace }
+
+// Name returns the name of the lease, which is unique within its
+// namespace.
+func (t LeaseTag) Name() string { return t.name }
+
+// NewLeaseTag returns the tag of the lease with the given id, such as
+// "application-leadership/mysql". It will panic if the given id is not
+// valid.
+func NewLeaseTag(id string) LeaseTag {
+	t, ok := splitLeaseId(id, "/")
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid lease id", id))
+	}
+	return t
+}
+
+// ParseLeaseTag parses a lease tag string.
+func ParseLeaseTag(leaseTag string) (LeaseTag, error) {
+	tag, err := ParseTag(leaseTag)
+	if err != nil {
+		return LeaseTag{}, err
+	}
+	lt, ok := tag.(LeaseTag)
+	if !ok {
+		return LeaseTag{}, invalidTagError(leaseTag, LeaseTagKind)
+	}
+	return lt, nil
+}
+
+// splitLeaseId splits a lease id, or the tag suffix of one, into its
+// namespace and name, validating both.
+func splitLeaseId(id, sep string) (LeaseTag, bool) {
+	namespace, name, ok := strings.Cut(id, sep)
+	if !ok || !IsValidLeaseNamespace(namespace) || !IsValidLeaseName(name) {
+		return LeaseTag{}, false
+	}
+	return LeaseTag{namespace: namespace, name: name}, true
+}
@@ -0,0 +1,102 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type leaseSuite struct{}
+
+var _ = gc.Suite(&leaseSuite{})
+
+var leaseIdTests = []struct {
+	id        string
+	valid     bool
+	namespace string
+	name      string
+	tag       string
+}{
+	{id: "application-leadership/mysql", valid: true, namespace: "application-leadership", name: "mysql", tag: "lease-application-leadership_mysql"},
+	{
+		id:        "singular-controller/f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		valid:     true,
+		namespace: "singular-controller",
+		name:      "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		tag:       "lease-singular-controller_f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	},
+	{id: "ns2/lease_1.a", valid: true, namespace: "ns2", name: "lease_1.a", tag: "lease-ns2_lease_1.a"},
+	{id: ""},
+	{id: "application-leadership"},
+	{id: "application-leadership/"},
+	{id: "/mysql"},
+	{id: "Application-leadership/mysql"},
+	{id: "1-leadership/mysql"},
+	{id: "application--leadership/mysql"},
+	{id: "application-leadership-/mysql"},
+	{id: "application_leadership/mysql"},
+	{id: "application-leadership/-mysql"},
+	{id: "application-leadership/mysql/0"},
+	{id: "application-leadership/my sql"},
+}
+
+func (s *leaseSuite) TestLeaseTag(c *gc.C) {
+	for i, test := range leaseIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidLease(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid lease id", test.id)
+			testTag := func() { names.NewLeaseTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewLeaseTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Namespace(), gc.Equals, test.namespace)
+		c.Check(tag.Name(), gc.Equals, test.name)
+		parsed, err := names.ParseLeaseTag(test.tag)
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseLeaseTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "lease-application-leadership_mysql",
+	expected: names.NewLeaseTag("application-leadership/mysql"),
+}, {
+	tag: "lease-application-leadership/mysql",
+	err: names.InvalidTagError("lease-application-leadership/mysql", names.LeaseTagKind),
+}, {
+	tag: "lease-application-leadership",
+	err: names.InvalidTagError("lease-application-leadership", names.LeaseTagKind),
+}, {
+	tag: "service-mysql",
+	err: names.InvalidTagError("service-mysql", names.LeaseTagKind),
+}}
+
+func (s *leaseSuite) TestParseLeaseTag(c *gc.C) {
+	for i, t := range parseLeaseTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseLeaseTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -4,28 +4,89 @@
 package names
 
 import (
-	"regexp"
 	"strings"
+	"sync/atomic"
 )
 
 const MachineTagKind = "machine"
 
+// ContainerSeparator separates the parts of a container machine id,
+// such as "0/lxc/1".
+const ContainerSeparator = "/"
+
 const (
 	ContainerTypeSnippet = "[a-z]+"
 	ContainerSnippet     = "/" + ContainerTypeSnippet + "/" + NumberSnippet + ""
 	MachineSnippet       = NumberSnippet + "(?:" + ContainerSnippet + ")*"
 )
 
-var validMachine = regexp.MustCompile("^" + MachineSnippet + "$")
+// DefaultMaxContainerDepth is the greatest container nesting depth
+// permitted in a valid machine id unless SetMaxContainerDepth sets
+// another. For example, "0/lxc/1" has a depth of 1 and "0/kvm/1/lxc/2"
+// has a depth of 2. Machine ids nested more deeply, which were valid
+// before the limit was introduced, are rejected by IsValidMachine,
+// ParseTag and ParseMachineTag unless the limit is raised.
+const DefaultMaxContainerDepth = 2
+
+// maxContainerDepth holds the limit enforced by IsValidMachine.
+var maxContainerDepth atomic.Int64
+
+func init() {
+	maxContainerDepth.Store(DefaultMaxContainerDepth)
+}
+
+// MaxContainerDepth returns the greatest container nesting depth
+// permitted in a valid machine id, or zero if nesting is unlimited.
+func MaxContainerDepth() int {
+	return int(maxContainerDepth.Load())
+}
+
+// SetMaxContainerDepth sets the greatest container nesting depth
+// permitted by IsValidMachine, and so by ParseTag, ParseMachineTag and
+// the decoding methods of MachineTag, and returns the previous limit.
+// If depth is zero or negative, nesting is unlimited. It is safe to
+// call concurrently with validation; use a MachineValidator to apply a
+// different limit to particular ids only.
+func SetMaxContainerDepth(depth int) int {
+	if depth < 0 {
+		depth = 0
+	}
+	return int(maxContainerDepth.Swap(int64(depth)))
+}
 
-// IsValidMachine returns whether id is a valid machine id.
+// IsValidMachine returns whether id is a valid machine id, nested no
+// more deeply than MaxContainerDepth permits.
 func IsValidMachine(id string) bool {
-	return validMachine.MatchString(id)
+	return MachineValidator{MaxContainerDepth: MaxContainerDepth()}.IsValidMachine(id)
+}
+
+// MachineValidator validates machine ids against a container nesting
+// depth limit other than the package-wide one.
+type MachineValidator struct {
+	// MaxContainerDepth holds the greatest container nesting depth
+	// permitted in a valid machine id. If it is zero or negative,
+	// nesting is unlimited.
+	MaxContainerDepth int
+}
+
+// IsValidMachine returns whether id is a valid machine id under the
+// validator's depth limit.
+func (v MachineValidator) IsValidMachine(id string) bool {
+	if !matchMachine(id) {
+		return false
+	}
+	return v.MaxContainerDepth <= 0 || containerDepth(id) <= v.MaxContainerDepth
 }
 
 // IsContainerMachine returns whether id is a valid container machine id.
 func IsContainerMachine(id string) bool {
-	return validMachine.MatchString(id) && strings.Contains(id, "/")
+	return IsValidMachine(id) && strings.Contains(id, ContainerSeparator)
+}
+
+// containerDepth returns the number of nested containers in the
+// syntactically valid machine id.
+func containerDepth(id string) int {
+	return strings.Count(id, ContainerSeparator) / 2
 }
 
 type MachineTag struct {
@@ -36,9 +97,15 @@ func (t MachineTag) String() string { return t.Kind() + "-" + t.id }
 func (t MachineTag) Kind() string   { return MachineTagKind }
 func (t MachineTag) Id() string     { return machineTagSuffixToId(t.id) }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t MachineTag) AppendString(dst []byte) []byte {
+	return append(append(dst, MachineTagKind+KindSeparator...), t.id...)
+}
+
 // NewMachineTag returns the tag for the machine with the given id.
 func NewMachineTag(id string) MachineTag {
-	id = strings.Replace(id, "/", "-", -1)
+	id = strings.Replace(id, ContainerSeparator, KindSeparator, -1)
 	return MachineTag{id: id}
 }
 
@@ -56,5 +123,5 @@ func ParseMachineTag(machineTag string) (MachineTag, error) {
 }
 
 func machineTagSuffixToId(s string) string {
-	return strings.Replace(s, "-", "/", -1)
+	return strings.Replace(s, KindSeparator, ContainerSeparator, -1)
 }
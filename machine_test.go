@@ -94,3 +94,48 @@ func (s *machineSuite) TestParseMachineTag(c *gc.C) {
 		c.Check(got, gc.Equals, t.expected)
 	}
 }
+
+func (s *machineSuite) TestMaxContainerDepth(c *gc.C) {
+	// By default, ids nested three deep, which were once valid, are
+	// rejected.
+	c.Check(names.MaxContainerDepth(), gc.Equals, names.DefaultMaxContainerDepth)
+	c.Check(names.IsValidMachine("0/lxc/1/kvm/2"), gc.Equals, true)
+	c.Check(names.IsValidMachine("0/lxc/1/kvm/2/lxc/3"), gc.Equals, false)
+	c.Check(names.IsContainerMachine("0/lxc/1/kvm/2/lxc/3"), gc.Equals, false)
+	_, err := names.ParseMachineTag("machine-0-lxc-1-kvm-2-lxc-3")
+	c.Check(err, gc.ErrorMatches, `"machine-0-lxc-1-kvm-2-lxc-3" is not a valid machine tag`)
+	_, err = names.ParseTag("machine-0-lxc-1-kvm-2-lxc-3")
+	c.Check(err, gc.ErrorMatches, `"machine-0-lxc-1-kvm-2-lxc-3" is not a valid machine tag`)
+
+	// Raising the limit lets them be validated and parsed again.
+	prev := names.SetMaxContainerDepth(3)
+	defer names.SetMaxContainerDepth(prev)
+	c.Check(prev, gc.Equals, names.DefaultMaxContainerDepth)
+	c.Check(names.IsValidMachine("0/lxc/1/kvm/2/lxc/3"), gc.Equals, true)
+	c.Check(names.IsValidMachine("0/lxc/1/kvm/2/lxc/3/lxc/4"), gc.Equals, false)
+	tag, err := names.ParseMachineTag("machine-0-lxc-1-kvm-2-lxc-3")
+	c.Check(err, gc.IsNil)
+	c.Check(tag.Id(), gc.Equals, "0/lxc/1/kvm/2/lxc/3")
+	parsed, err := names.ParseTag("machine-0-lxc-1-kvm-2-lxc-3")
+	c.Check(err, gc.IsNil)
+	c.Check(parsed, gc.Equals, tag)
+	var decoded names.MachineTag
+	c.Check(decoded.UnmarshalText([]byte("machine-0-lxc-1-kvm-2-lxc-3")), gc.IsNil)
+	c.Check(decoded, gc.Equals, tag)
+
+	// A limit of zero or less means nesting is unlimited.
+	c.Check(names.SetMaxContainerDepth(-1), gc.Equals, 3)
+	c.Check(names.MaxContainerDepth(), gc.Equals, 0)
+	c.Check(names.IsValidMachine("0/lxc/1/kvm/2/lxc/3/lxc/4"), gc.Equals, true)
+}
+
+func (s *machineSuite) TestMachineValidator(c *gc.C) {
+	v := names.MachineValidator{MaxContainerDepth: 1}
+	c.Check(v.IsValidMachine("0"), gc.Equals, true)
+	c.Check(v.IsValidMachine("0/lxc/1"), gc.Equals, true)
+	c.Check(v.IsValidMachine("0/lxc/1/kvm/2"), gc.Equals, false)
+
+	v = names.MachineValidator{}
+	c.Check(v.IsValidMachine("0/lxc/1/kvm/2/lxc/3/lxc/4"), gc.Equals, true)
+	c.Check(v.IsValidMachine("0/lxc/1/kvm/2/lxc/03"), gc.Equals, false)
+}
@@ -0,0 +1,268 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+//go:build tinygo || names_noregexp
+
+package names
+
+import "strings"
+
+// The validators in this file are hand-written equivalents of the
+// regular expressions in match_regexp.go, for builds that cannot
+// afford the regexp package.
+
+func isLower(c byte) bool { return c >= 'a' && c <= 'z' }
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+func isHex(c byte) bool   { return isDigit(c) || c >= 'a' && c <= 'f' }
+
+// allBytes returns whether s is not empty and every byte of s
+// satisfies f.
+func allBytes(s string, f func(byte) bool) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !f(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isLowerAlnumByte(c byte) bool { return isLower(c) || isDigit(c) }
+
+func isAlnumByte(c byte) bool { return isLowerAlnumByte(c) || c >= 'A' && c <= 'Z' }
+
+// matchNumber matches NumberSnippet.
+func matchNumber(s string) bool {
+	return s == "0" || allBytes(s, isDigit) && s[0] != '0'
+}
+
+// matchUUID returns whether s contains an environment UUID.
+func matchUUID(s string) bool {
+	const uuidLen = 36
+	for i := 0; i+uuidLen <= len(s); i++ {
+		if isUUID(s[i : i+uuidLen]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isUUID(s string) bool {
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHex(s[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchMachine returns whether s is a syntactically valid machine id.
+func matchMachine(s string) bool {
+	parts := strings.Split(s, "/")
+	if len(parts)%2 == 0 || !matchNumber(parts[0]) {
+		return false
+	}
+	for i := 1; i < len(parts); i += 2 {
+		if !allBytes(parts[i], isLower) || !matchNumber(parts[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchControllerAgent returns whether s is a valid controller agent
+// id.
+func matchControllerAgent(s string) bool {
+	return matchNumber(s)
+}
+
+// matchNetwork returns whether s is a valid network name.
+func matchNetwork(s string) bool {
+	for _, part := range strings.Split(s, "-") {
+		if !allBytes(part, isLowerAlnumByte) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchSpace returns whether s is a valid space name.
+func matchSpace(s string) bool {
+	return s != "" && isLower(s[0]) && matchNetwork(s)
+}
+
+// matchCloud returns whether s is a valid cloud name.
+func matchCloud(s string) bool {
+	for _, part := range strings.Split(s, "-") {
+		if !allBytes(part, isAlnumByte) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchAvailabilityZone returns whether s is a valid availability
+// zone name.
+func matchAvailabilityZone(s string) bool {
+	// Separators may appear only singly between letters and digits.
+	for i := 0; i < len(s); i++ {
+		if isAlnumByte(s[i]) {
+			continue
+		}
+		if s[i] != '-' && s[i] != '_' && s[i] != '.' || i == 0 || i == len(s)-1 || !isAlnumByte(s[i-1]) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// matchCloudCredentialName returns whether s is a valid cloud
+// credential name.
+func matchCloudCredentialName(s string) bool {
+	return s != "" && isAlnumByte(s[0]) && allBytes(s, func(c byte) bool {
+		return isAlnumByte(c) || c == '.' || c == '_' || c == '-'
+	})
+}
+
+// matchLeaseNamespace returns whether s is a valid lease namespace.
+func matchLeaseNamespace(s string) bool {
+	if s == "" || !isLower(s[0]) {
+		return false
+	}
+	for _, part := range strings.Split(s, "-") {
+		if !allBytes(part, isLowerAlnumByte) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchResourceName returns whether s is a valid resource name.
+func matchResourceName(s string) bool {
+	return matchRelationName(s)
+}
+
+// matchEnvironName returns whether s is a valid environment name.
+func matchEnvironName(s string) bool {
+	return s != "" && isLowerAlnumByte(s[0]) && allBytes(s, func(c byte) bool {
+		return isLowerAlnumByte(c) || c == '-'
+	})
+}
+
+// matchRelation returns whether s is a valid relation key.
+func matchRelation(s string) bool {
+	endpoints := strings.Split(s, " ")
+	if len(endpoints) > 2 {
+		return false
+	}
+	for _, endpoint := range endpoints {
+		i := strings.Index(endpoint, ":")
+		if i < 0 || !matchService(endpoint[:i]) || !matchRelationName(endpoint[i+1:]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchRelationName matches RelationSnippet.
+func matchRelationName(s string) bool {
+	if s == "" || !isLower(s[0]) {
+		return false
+	}
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' })
+	if strings.Count(s, "_")+strings.Count(s, "-")+1 != len(parts) {
+		// There are leading, trailing or adjacent separators.
+		return false
+	}
+	for _, part := range parts {
+		if !allBytes(part, isLowerAlnumByte) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchService returns whether s is a valid service name.
+func matchService(s string) bool {
+	if s == "" || !isLower(s[0]) {
+		return false
+	}
+	for _, part := range strings.Split(s, "-") {
+		if !allBytes(part, isLowerAlnumByte) || !strings.ContainsAny(part, "abcdefghijklmnopqrstuvwxyz") {
+			return false
+		}
+	}
+	return true
+}
+
+// matchUnit returns the service name of the valid unit name s.
+func matchUnit(s string) (service string, ok bool) {
+	i := strings.Index(s, "/")
+	if i < 0 || !matchService(s[:i]) || !matchNumber(s[i+1:]) {
+		return "", false
+	}
+	return s[:i], true
+}
+
+// matchUser returns the name and provider of the valid user id s.
+func matchUser(s string) (name, provider string, ok bool) {
+	name = s
+	if i := strings.Index(s, "@"); i >= 0 {
+		name, provider = s[:i], s[i+1:]
+		if !matchUserName(provider) {
+			return "", "", false
+		}
+	}
+	if !matchUserName(name) {
+		return "", "", false
+	}
+	return name, provider, true
+}
+
+// matchUserName returns whether s is a valid user name.
+func matchUserName(s string) bool {
+	if len(s) < 2 || !isAlnumByte(s[0]) || !isAlnumByte(s[len(s)-1]) {
+		return false
+	}
+	return allBytes(s, func(c byte) bool {
+		return isAlnumByte(c) || c == '.' || c == '-'
+	})
+}
+
+// matchVolume returns whether s is a valid volume id.
+func matchVolume(s string) bool {
+	i := strings.LastIndex(s, "/")
+	if i < 0 {
+		return matchNumber(s)
+	}
+	if !matchNumber(s[i+1:]) {
+		return false
+	}
+	_, ok := matchUnit(s[:i])
+	return ok || matchMachine(s[:i])
+}
+
+// matchStorageName returns whether s is a valid charm storage name.
+// Storage names follow the same rules as service names.
+func matchStorageName(s string) bool {
+	return matchService(s)
+}
+
+// matchStorage returns the storage name of the valid storage instance
+// id s.
+func matchStorage(s string) (name string, ok bool) {
+	i := strings.Index(s, "/")
+	if i < 0 || !matchStorageName(s[:i]) || !matchNumber(s[i+1:]) {
+		return "", false
+	}
+	return s[:i], true
+}
@@ -0,0 +1,176 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+//go:build !tinygo && !names_noregexp
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// The validators in this file are built from the exported snippets.
+// Builds that cannot afford the regexp package, such as TinyGo and
+// WebAssembly builds, use the hand-written equivalents in
+// match_noregexp.go instead; build with the names_noregexp tag to
+// select them explicitly.
+
+var validUUID = regexp.MustCompile(`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`)
+
+var validMachine = regexp.MustCompile("^" + MachineSnippet + "$")
+
+var validNumber = regexp.MustCompile("^" + NumberSnippet + "$")
+
+var validNetwork = regexp.MustCompile("^" + NetworkSnippet + "$")
+
+var validSpace = regexp.MustCompile("^" + SpaceSnippet + "$")
+
+var validCloud = regexp.MustCompile("^" + CloudSnippet + "$")
+
+var validAvailabilityZone = regexp.MustCompile("^" + AvailabilityZoneSnippet + "$")
+
+var validCloudCredentialName = regexp.MustCompile("^" + CloudCredentialNameSnippet + "$")
+
+var validLeaseNamespace = regexp.MustCompile("^" + LeaseNamespaceSnippet + "$")
+
+var validResourceName = regexp.MustCompile("^" + ResourceNameSnippet + "$")
+
+var validEnvironName = regexp.MustCompile("^[a-z0-9]+[a-z0-9-]*$")
+
+var (
+	validRelation     = regexp.MustCompile("^" + ServiceSnippet + ":" + RelationSnippet + " " + ServiceSnippet + ":" + RelationSnippet + "$")
+	validPeerRelation = regexp.MustCompile("^" + ServiceSnippet + ":" + RelationSnippet + "$")
+)
+
+var validVolume = regexp.MustCompile("^" + VolumeSnippet + "$")
+
+var (
+	validStorageName = regexp.MustCompile("^" + StorageNameSnippet + "$")
+	validStorage     = regexp.MustCompile("^(" + StorageNameSnippet + ")/" + NumberSnippet + "$")
+)
+
+var validService = regexp.MustCompile("^" + ServiceSnippet + "$")
+
+var validUnit = regexp.MustCompile("^(" + ServiceSnippet + ")/" + NumberSnippet + "$")
+
+var validPart = "[a-zA-Z0-9][a-zA-Z0-9.-]*[a-zA-Z0-9]"
+
+var validName = regexp.MustCompile(fmt.Sprintf("^(?P<name>%s)(?:@(?P<provider>%s))?$", validPart, validPart))
+var validUserName = regexp.MustCompile("^" + validPart + "$")
+
+// matchUUID returns whether s contains an environment UUID.
+func matchUUID(s string) bool {
+	return validUUID.MatchString(s)
+}
+
+// matchMachine returns whether s is a syntactically valid machine id.
+func matchMachine(s string) bool {
+	return validMachine.MatchString(s)
+}
+
+// matchNumber matches NumberSnippet.
+func matchNumber(s string) bool {
+	return validNumber.MatchString(s)
+}
+
+// matchControllerAgent returns whether s is a valid controller agent
+// id.
+func matchControllerAgent(s string) bool {
+	return matchNumber(s)
+}
+
+// matchNetwork returns whether s is a valid network name.
+func matchNetwork(s string) bool {
+	return validNetwork.MatchString(s)
+}
+
+// matchSpace returns whether s is a valid space name.
+func matchSpace(s string) bool {
+	return validSpace.MatchString(s)
+}
+
+// matchCloud returns whether s is a valid cloud name.
+func matchCloud(s string) bool {
+	return validCloud.MatchString(s)
+}
+
+// matchAvailabilityZone returns whether s is a valid availability
+// zone name.
+func matchAvailabilityZone(s string) bool {
+	return validAvailabilityZone.MatchString(s)
+}
+
+// matchCloudCredentialName returns whether s is a valid cloud
+// credential name.
+func matchCloudCredentialName(s string) bool {
+	return validCloudCredentialName.MatchString(s)
+}
+
+// matchLeaseNamespace returns whether s is a valid lease namespace.
+func matchLeaseNamespace(s string) bool {
+	return validLeaseNamespace.MatchString(s)
+}
+
+// matchResourceName returns whether s is a valid resource name.
+func matchResourceName(s string) bool {
+	return validResourceName.MatchString(s)
+}
+
+// matchEnvironName returns whether s is a valid environment name.
+func matchEnvironName(s string) bool {
+	return validEnvironName.MatchString(s)
+}
+
+// matchRelation returns whether s is a valid relation key.
+func matchRelation(s string) bool {
+	return validRelation.MatchString(s) || validPeerRelation.MatchString(s)
+}
+
+// matchService returns whether s is a valid service name.
+func matchService(s string) bool {
+	return validService.MatchString(s)
+}
+
+// matchUnit returns the service name of the valid unit name s.
+func matchUnit(s string) (service string, ok bool) {
+	parts := validUnit.FindStringSubmatch(s)
+	if parts == nil {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// matchUser returns the name and provider of the valid user id s.
+func matchUser(s string) (name, provider string, ok bool) {
+	parts := validName.FindStringSubmatch(s)
+	if len(parts) != 3 {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// matchUserName returns whether s is a valid user name.
+func matchUserName(s string) bool {
+	return validUserName.MatchString(s)
+}
+
+// matchVolume returns whether s is a valid volume id.
+func matchVolume(s string) bool {
+	return validVolume.MatchString(s)
+}
+
+// matchStorageName returns whether s is a valid charm storage name.
+func matchStorageName(s string) bool {
+	return validStorageName.MatchString(s)
+}
+
+// matchStorage returns the storage name of the valid storage instance
+// id s.
+func matchStorage(s string) (name string, ok bool) {
+	parts := validStorage.FindStringSubmatch(s)
+	if parts == nil {
+		return "", false
+	}
+	return parts[1], true
+}
@@ -0,0 +1,111 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+)
+
+type matchSuite struct{}
+
+var _ = gc.Suite(&matchSuite{})
+
+// The validators are checked against regular expressions built here
+// from the snippets, so that the hand-written validators are checked
+// when the tests are run with the names_noregexp tag.
+var (
+	userPart     = "[a-zA-Z0-9][a-zA-Z0-9.-]*[a-zA-Z0-9]"
+	relationPart = ServiceSnippet + ":" + RelationSnippet
+)
+
+var matchers = []struct {
+	name  string
+	re    *regexp.Regexp
+	match func(string) bool
+}{
+	{"uuid", regexp.MustCompile(`[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}`), matchUUID},
+	{"machine", regexp.MustCompile("^" + MachineSnippet + "$"), matchMachine},
+	{"number", regexp.MustCompile("^" + NumberSnippet + "$"), matchNumber},
+	{"controller agent", regexp.MustCompile("^" + NumberSnippet + "$"), matchControllerAgent},
+	{"network", regexp.MustCompile("^" + NetworkSnippet + "$"), matchNetwork},
+	{"space", regexp.MustCompile("^" + SpaceSnippet + "$"), matchSpace},
+	{"cloud", regexp.MustCompile("^" + CloudSnippet + "$"), matchCloud},
+	{"availability zone", regexp.MustCompile("^" + AvailabilityZoneSnippet + "$"), matchAvailabilityZone},
+	{"cloud credential name", regexp.MustCompile("^" + CloudCredentialNameSnippet + "$"), matchCloudCredentialName},
+	{"lease namespace", regexp.MustCompile("^" + LeaseNamespaceSnippet + "$"), matchLeaseNamespace},
+	{"resource name", regexp.MustCompile("^" + ResourceNameSnippet + "$"), matchResourceName},
+	{"environ name", regexp.MustCompile("^[a-z0-9]+[a-z0-9-]*$"), matchEnvironName},
+	{"relation", regexp.MustCompile("^" + relationPart + "(?: " + relationPart + ")?$"), matchRelation},
+	{"service", regexp.MustCompile("^" + ServiceSnippet + "$"), matchService},
+	{"unit", regexp.MustCompile("^" + ServiceSnippet + "/" + NumberSnippet + "$"), func(s string) bool {
+		_, ok := matchUnit(s)
+		return ok
+	}},
+	{"user", regexp.MustCompile("^" + userPart + "(?:@" + userPart + ")?$"), func(s string) bool {
+		_, _, ok := matchUser(s)
+		return ok
+	}},
+	{"user name", regexp.MustCompile("^" + userPart + "$"), matchUserName},
+	{"volume", regexp.MustCompile("^" + VolumeSnippet + "$"), matchVolume},
+	{"storage name", regexp.MustCompile("^" + StorageNameSnippet + "$"), matchStorageName},
+	{"storage", regexp.MustCompile("^" + StorageNameSnippet + "/" + NumberSnippet + "$"), func(s string) bool {
+		_, ok := matchStorage(s)
+		return ok
+	}},
+}
+
+// matchInputs returns every string of up to four characters drawn from
+// an alphabet of significant characters, and some longer strings.
+func matchInputs() []string {
+	const alphabet = "a0-1/:@. _Z"
+	inputs := []string{""}
+	for n, start := 0, 0; n < 4; n++ {
+		end := len(inputs)
+		for _, prefix := range inputs[start:end] {
+			for _, c := range alphabet {
+				inputs = append(inputs, prefix+string(c))
+			}
+		}
+		start = end
+	}
+	return append(inputs,
+		"0/lxc/1", "0/kvm/10/lxc/02", "0/lxc/1/", "mysql-5-a/10", "my-sql2/0",
+		"wordpress:db mysql:server", "wordpress:db_1 mysql:server", "wordpress:db__1",
+		"wordpress:db mysql:server ", "bob.smith@example.com", "bob@foo@bar",
+		"f47ac10b-58cc-4372-a567-0e02b2c3d479", "xf47ac10b-58cc-4372-a567-0e02b2c3d479y",
+		"f47ac10b-58cc-4372-a567-0e02b2c3d47", "F47AC10B-58CC-4372-A567-0E02B2C3D479",
+		"0/lxc/1/3", "mysql/0/3", "my-sql/10/3", "mysql/0/lxc/3", "0/mysql/3",
+	)
+}
+
+func (s *matchSuite) TestMatchers(c *gc.C) {
+	inputs := matchInputs()
+	for _, m := range matchers {
+		failures := 0
+		for _, input := range inputs {
+			if got, want := m.match(input), m.re.MatchString(input); got != want {
+				c.Errorf("%s: %q: got %v, want %v", m.name, input, got, want)
+				if failures++; failures > 10 {
+					break
+				}
+			}
+		}
+	}
+}
+
+func (s *matchSuite) TestSubmatches(c *gc.C) {
+	service, ok := matchUnit("wordpress-site/12")
+	c.Check(ok, gc.Equals, true)
+	c.Check(service, gc.Equals, "wordpress-site")
+
+	name, provider, ok := matchUser("bob.smith@remote")
+	c.Check(ok, gc.Equals, true)
+	c.Check(fmt.Sprint(name, " ", provider), gc.Equals, "bob.smith remote")
+	name, provider, ok = matchUser("bob")
+	c.Check(ok, gc.Equals, true)
+	c.Check(fmt.Sprint(name, " ", provider), gc.Equals, "bob ")
+}
@@ -0,0 +1,53 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const MetricBatchTagKind = "metricbatch"
+
+// IsValidMetricBatch returns whether id is a valid metric batch UUID.
+func IsValidMetricBatch(id string) bool {
+	_, ok := parseUUID(id)
+	return ok
+}
+
+// MetricBatchTag represents a batch of metrics collected from a unit.
+type MetricBatchTag struct {
+	uuid string
+}
+
+func (t MetricBatchTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t MetricBatchTag) Kind() string   { return MetricBatchTagKind }
+func (t MetricBatchTag) Id() string     { return t.uuid }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t MetricBatchTag) AppendString(dst []byte) []byte {
+	return append(append(dst, MetricBatchTagKind+KindSeparator...), t.uuid...)
+}
+
+// NewMetricBatchTag returns the tag of the metric batch with the given
+// UUID. It will panic if the given UUID is not valid.
+func NewMetricBatchTag(uuid string) MetricBatchTag {
+	if !IsValidMetricBatch(uuid) {
+		panic(fmt.Sprintf("%q is not a valid metric batch UUID", uuid))
+	}
+	return MetricBatchTag{uuid: uuid}
+}
+
+// ParseMetricBatchTag parses a metric batch tag string.
+func ParseMetricBatchTag(metricBatchTag string) (MetricBatchTag, error) {
+	tag, err := ParseTag(metricBatchTag)
+	if err != nil {
+		return MetricBatchTag{}, err
+	}
+	mt, ok := tag.(MetricBatchTag)
+	if !ok {
+		return MetricBatchTag{}, invalidTagError(metricBatchTag, MetricBatchTagKind)
+	}
+	return mt, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type metricBatchSuite struct{}
+
+var _ = gc.Suite(&metricBatchSuite{})
+
+func (s *metricBatchSuite) TestIsValidMetricBatch(c *gc.C) {
+	c.Check(names.IsValidMetricBatch("f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, true)
+	c.Check(names.IsValidMetricBatch("xf47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, false)
+	c.Check(names.IsValidMetricBatch("F47AC10B-58CC-4372-A567-0E02B2C3D479"), gc.Equals, false)
+	c.Check(names.IsValidMetricBatch("f47ac10b"), gc.Equals, false)
+	c.Check(names.IsValidMetricBatch(""), gc.Equals, false)
+	c.Check(func() { names.NewMetricBatchTag("f47ac10b") }, gc.PanicMatches, `"f47ac10b" is not a valid metric batch UUID`)
+}
+
+var parseMetricBatchTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "metricbatch-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewMetricBatchTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "metricbatch-f47ac10b",
+	err: names.InvalidTagError("metricbatch-f47ac10b", names.MetricBatchTagKind),
+}, {
+	tag: "metricbatch",
+	err: names.InvalidTagError("metricbatch", ""),
+}, {
+	tag: "model-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	err: names.InvalidTagError("model-f47ac10b-58cc-4372-a567-0e02b2c3d479", names.MetricBatchTagKind),
+}}
+
+func (s *metricBatchSuite) TestParseMetricBatchTag(c *gc.C) {
+	for i, t := range parseMetricBatchTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseMetricBatchTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const MigrationTagKind = "migration"
+
+// IsValidMigration returns whether id is a valid migration UUID.
+func IsValidMigration(id string) bool {
+	_, ok := parseUUID(id)
+	return ok
+}
+
+// MigrationTag represents an attempt to migrate a model to another
+// controller, against which the phases of the migration are recorded.
+type MigrationTag struct {
+	uuid string
+}
+
+func (t MigrationTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t MigrationTag) Kind() string   { return MigrationTagKind }
+func (t MigrationTag) Id() string     { return t.uuid }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t MigrationTag) AppendString(dst []byte) []byte {
+	return append(append(dst, MigrationTagKind+KindSeparator...), t.uuid...)
+}
+
+// NewMigrationTag returns the tag of the migration with the given UUID.
+// It will panic if the given UUID is not valid.
+func NewMigrationTag(uuid string) MigrationTag {
+	if !IsValidMigration(uuid) {
+		panic(fmt.Sprintf("%q is not a valid migration UUID", uuid))
+	}
+	return MigrationTag{uuid: uuid}
+}
+
+// ParseMigrationTag parses a migration tag string.
+func ParseMigrationTag(migrationTag string) (MigrationTag, error) {
+	tag, err := ParseTag(migrationTag)
+	if err != nil {
+		return MigrationTag{}, err
+	}
+	mt, ok := tag.(MigrationTag)
+	if !ok {
+		return MigrationTag{}, invalidTagError(migrationTag, MigrationTagKind)
+	}
+	return mt, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type migrationSuite struct{}
+
+var _ = gc.Suite(&migrationSuite{})
+
+func (s *migrationSuite) TestIsValidMigration(c *gc.C) {
+	c.Check(names.IsValidMigration("f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, true)
+	c.Check(names.IsValidMigration("xf47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, false)
+	c.Check(names.IsValidMigration("F47AC10B-58CC-4372-A567-0E02B2C3D479"), gc.Equals, false)
+	c.Check(names.IsValidMigration("f47ac10b"), gc.Equals, false)
+	c.Check(names.IsValidMigration(""), gc.Equals, false)
+	c.Check(func() { names.NewMigrationTag("f47ac10b") }, gc.PanicMatches, `"f47ac10b" is not a valid migration UUID`)
+}
+
+var parseMigrationTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "migration-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewMigrationTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "migration-f47ac10b",
+	err: names.InvalidTagError("migration-f47ac10b", names.MigrationTagKind),
+}, {
+	tag: "migration",
+	err: names.InvalidTagError("migration", ""),
+}, {
+	tag: "metricbatch-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	err: names.InvalidTagError("metricbatch-f47ac10b-58cc-4372-a567-0e02b2c3d479", names.MigrationTagKind),
+}}
+
+func (s *migrationSuite) TestParseMigrationTag(c *gc.C) {
+	for i, t := range parseMigrationTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseMigrationTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
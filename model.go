@@ -0,0 +1,91 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const ModelTagKind = "model"
+
+// ModelTag is the successor to EnvironTag: a model is an environment
+// under its new name, and is identified by the same UUID. Use
+// EnvironTag.ModelTag and ModelTag.EnvironTag to convert between the
+// two while code migrates.
+type ModelTag struct {
+	uuid string
+}
+
+// NewModelTag returns the tag of a model with the given model UUID. It
+// will panic if the given UUID is not valid.
+func NewModelTag(uuid string) ModelTag {
+	if !IsValidModel(uuid) {
+		panic(fmt.Sprintf("%q is not a valid model UUID", uuid))
+	}
+	return ModelTag{uuid: uuid}
+}
+
+// ParseModelTag parses a model tag string.
+func ParseModelTag(modelTag string) (ModelTag, error) {
+	tag, err := ParseTag(modelTag)
+	if err != nil {
+		return ModelTag{}, err
+	}
+	mt, ok := tag.(ModelTag)
+	if !ok {
+		return ModelTag{}, invalidTagError(modelTag, ModelTagKind)
+	}
+	return mt, nil
+}
+
+func (t ModelTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ModelTag) Kind() string   { return ModelTagKind }
+func (t ModelTag) Id() string     { return t.uuid }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ModelTag) AppendString(dst []byte) []byte {
+	return append(append(dst, ModelTagKind+KindSeparator...), t.uuid...)
+}
+
+// IsValidModel returns whether id is a valid model UUID.
+func IsValidModel(id string) bool {
+	_, ok := parseUUID(id)
+	return ok
+}
+
+// IsValidModelName returns whether name is a valid model name. Model
+// names follow the rules of environment names: they consist of lower
+// case letters, digits and hyphens, and do not start with a hyphen.
+func IsValidModelName(name string) bool {
+	return matchEnvironName(name)
+}
+
+// ModelNameValidator validates model names, optionally rejecting
+// names that impersonate existing ones.
+type ModelNameValidator struct {
+	// Existing holds the names of existing models. If it is not
+	// empty, names that are visually confusable with, but not
+	// identical to, any of these names are invalid (see
+	// UserSkeleton).
+	Existing []string
+}
+
+// IsValidModelName returns whether name is a valid model name under
+// the validator's rules.
+func (v ModelNameValidator) IsValidModelName(name string) bool {
+	return IsValidModelName(name) && !isConfusableName(name, v.Existing)
+}
+
+// EnvironTag returns the tag of the environment with the same UUID as
+// the model.
+func (t ModelTag) EnvironTag() EnvironTag {
+	return EnvironTag{uuid: t.uuid}
+}
+
+// ModelTag returns the tag of the model with the same UUID as the
+// environment.
+func (t EnvironTag) ModelTag() ModelTag {
+	return ModelTag{uuid: t.uuid}
+}
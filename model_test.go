@@ -0,0 +1,72 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type modelSuite struct{}
+
+var _ = gc.Suite(&modelSuite{})
+
+var parseModelTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "model-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewModelTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "model-f47ac10b",
+	err: names.InvalidTagError("model-f47ac10b", names.ModelTagKind),
+}, {
+	tag: "model-zzf47ac10b-58cc-4372-a567-0e02b2c3d479zz",
+	err: names.InvalidTagError("model-zzf47ac10b-58cc-4372-a567-0e02b2c3d479zz", names.ModelTagKind),
+}, {
+	tag: "dave",
+	err: names.InvalidTagError("dave", ""),
+}, {
+	tag: "environment-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	err: names.InvalidTagError("environment-f47ac10b-58cc-4372-a567-0e02b2c3d479", names.ModelTagKind),
+}}
+
+func (s *modelSuite) TestParseModelTag(c *gc.C) {
+	for i, t := range parseModelTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseModelTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+func (s *modelSuite) TestIsValidModel(c *gc.C) {
+	c.Check(names.IsValidModel("f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, true)
+	c.Check(names.IsValidModel("f47ac10b-58cc-4372-a567"), gc.Equals, false)
+	c.Check(names.IsValidModel(""), gc.Equals, false)
+	c.Check(names.IsValidModel("zz"+"f47ac10b-58cc-4372-a567-0e02b2c3d479"+"zz"), gc.Equals, false)
+	c.Check(names.IsValidModel("F47AC10B-58CC-4372-A567-0E02B2C3D479"), gc.Equals, false)
+	c.Check(func() { names.NewModelTag("garbage") }, gc.PanicMatches, `"garbage" is not a valid model UUID`)
+}
+
+func (s *modelSuite) TestEnvironTagConversion(c *gc.C) {
+	const uuid = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	model := names.NewModelTag(uuid)
+	environ := names.NewEnvironTag(uuid)
+	c.Check(model.EnvironTag(), gc.Equals, environ)
+	c.Check(environ.ModelTag(), gc.Equals, model)
+	c.Check(model.EnvironTag().ModelTag(), gc.Equals, model)
+	c.Check(model.String(), gc.Equals, "model-"+uuid)
+	c.Check(model.EnvironTag().String(), gc.Equals, "environment-"+uuid)
+	c.Check(names.ModelTag{}.EnvironTag(), gc.Equals, names.EnvironTag{})
+}
@@ -0,0 +1,92 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package namesavro provides an Avro schema for Juju entity tags and
+// a codec for the Avro binary encoding of tags under that schema.
+package namesavro
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/juju/names"
+)
+
+// Schema holds the Avro schema for tags. The kind and id fields hold
+// the values returned by the Kind and Id methods of names.Tag.
+const Schema = `{
+  "type": "record",
+  "name": "Tag",
+  "namespace": "com.canonical.juju.names",
+  "doc": "A Juju entity tag.",
+  "fields": [
+    {"name": "kind", "type": "string"},
+    {"name": "id", "type": "string"}
+  ]
+}`
+
+// Marshal returns the Avro binary encoding of t.
+func Marshal(t names.Tag) []byte {
+	return Append(nil, t)
+}
+
+// Append appends the Avro binary encoding of t to dst and returns the
+// extended buffer.
+func Append(dst []byte, t names.Tag) []byte {
+	dst = appendString(dst, t.Kind())
+	return appendString(dst, t.Id())
+}
+
+// Unmarshal decodes the single Avro-encoded tag held in data,
+// validating it.
+func Unmarshal(data []byte) (names.Tag, error) {
+	t, n, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(data) {
+		return nil, fmt.Errorf("unexpected data after avro tag")
+	}
+	return t, nil
+}
+
+// Decode decodes and validates the Avro-encoded tag at the start of
+// data, returning the tag and the number of bytes read.
+func Decode(data []byte) (names.Tag, int, error) {
+	kind, n, err := readString(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	id, m, err := readString(data[n:])
+	if err != nil {
+		return nil, 0, err
+	}
+	t, err := names.TagFromKindId(kind, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return t, n + m, nil
+}
+
+// appendString appends the Avro encoding of s: its length as a
+// zig-zag varint, followed by its bytes.
+func appendString(dst []byte, s string) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], int64(len(s)))
+	dst = append(dst, buf[:n]...)
+	return append(dst, s...)
+}
+
+// readString reads an Avro-encoded string from the start of data,
+// returning it and the number of bytes read.
+func readString(data []byte) (string, int, error) {
+	size, n := binary.Varint(data)
+	if n <= 0 {
+		return "", 0, fmt.Errorf("invalid avro string length")
+	}
+	if size < 0 || size > int64(len(data)-n) {
+		return "", 0, fmt.Errorf("avro string length %d out of range", size)
+	}
+	end := n + int(size)
+	return string(data[n:end]), end, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namesavro_test
+
+import (
+	"encoding/json"
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+	"github.com/juju/names/namesavro"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type namesavroSuite struct{}
+
+var _ = gc.Suite(&namesavroSuite{})
+
+func (s *namesavroSuite) TestSchemaIsJSON(c *gc.C) {
+	var schema map[string]interface{}
+	c.Assert(json.Unmarshal([]byte(namesavro.Schema), &schema), gc.IsNil)
+	c.Check(schema["type"], gc.Equals, "record")
+	c.Check(schema["name"], gc.Equals, "Tag")
+}
+
+func (s *namesavroSuite) TestMarshal(c *gc.C) {
+	// Strings are encoded as a zig-zag varint length and the bytes.
+	data := namesavro.Marshal(names.NewUnitTag("mysql/0"))
+	c.Check(data, gc.DeepEquals, []byte("\x08unit\x0emysql/0"))
+}
+
+func (s *namesavroSuite) TestRoundTrip(c *gc.C) {
+	for i, tag := range []names.Tag{
+		names.NewUnitTag("rabbitmq-server/0"),
+		names.NewMachineTag("0/lxc/1"),
+		names.NewServiceTag("wordpress"),
+		names.NewUserTag("bob@local"),
+		names.NewRelationTag("wordpress:db mysql:server"),
+		names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+		names.JoinActionTag("mysql/0", 3),
+	} {
+		c.Logf("test %d: %s", i, tag)
+		got, err := namesavro.Unmarshal(namesavro.Marshal(tag))
+		c.Check(err, gc.IsNil)
+		c.Check(got, gc.Equals, tag)
+	}
+}
+
+func (s *namesavroSuite) TestDecodeStream(c *gc.C) {
+	data := namesavro.Marshal(names.NewMachineTag("1"))
+	data = namesavro.Append(data, names.NewServiceTag("mysql"))
+	first, n, err := namesavro.Decode(data)
+	c.Assert(err, gc.IsNil)
+	c.Check(first, gc.Equals, names.NewMachineTag("1"))
+	second, m, err := namesavro.Decode(data[n:])
+	c.Assert(err, gc.IsNil)
+	c.Check(second, gc.Equals, names.NewServiceTag("mysql"))
+	c.Check(n+m, gc.Equals, len(data))
+}
+
+func (s *namesavroSuite) TestUnmarshalErrors(c *gc.C) {
+	for i, test := range []struct {
+		data string
+		err  string
+	}{
+		{"", `invalid avro string length`},
+		{"\x08unit", `invalid avro string length`},
+		{"\x08uni", `avro string length 4 out of range`},
+		{"\x03unit", `avro string length -2 out of range`},
+		{"\x08unit\x0amysql", `"mysql" is not a valid unit id`},
+		{"\x0emachine\x021\x00", `unexpected data after avro tag`},
+	} {
+		c.Logf("test %d: %q", i, test.data)
+		_, err := namesavro.Unmarshal([]byte(test.data))
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
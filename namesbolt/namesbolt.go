@@ -0,0 +1,171 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package namesbolt provides a names.TagStore held on disk in a bbolt
+// database, for indexes of tags too large to hold in memory.
+package namesbolt
+
+import (
+	"bytes"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/juju/names"
+)
+
+// rootBucket holds the name of the bucket holding the tags. It holds a
+// nested bucket for each kind of tag, keyed by id.
+var rootBucket = []byte("juju-names-tags")
+
+// Store is a names.TagStore held in a bbolt database. It is safe for
+// concurrent use.
+type Store struct {
+	db *bolt.DB
+}
+
+var _ names.TagStore = (*Store)(nil)
+
+// Open opens the store held in the database file at the given path,
+// creating the file if it does not exist.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	s, err := New(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// New returns a store held in the given database, which may be shared
+// with other data. The store's tags are kept in their own bucket.
+func New(db *bolt.DB) (*Store, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(rootBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create tag bucket: %v", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// DB returns the database holding the store.
+func (s *Store) DB() *bolt.DB {
+	return s.db
+}
+
+// Close closes the database holding the store.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Add implements names.TagStore.Add. All the tags are added in a single
+// transaction.
+func (s *Store) Add(tags ...names.Tag) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket(rootBucket)
+		for _, t := range tags {
+			if t.Id() == "" {
+				return fmt.Errorf("cannot store %q: empty id", t)
+			}
+			b, err := root.CreateBucketIfNotExists([]byte(t.Kind()))
+			if err != nil {
+				return err
+			}
+			if err := b.Put([]byte(t.Id()), []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Remove implements names.TagStore.Remove. All the tags are removed in
+// a single transaction.
+func (s *Store) Remove(tags ...names.Tag) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket(rootBucket)
+		for _, t := range tags {
+			b := root.Bucket([]byte(t.Kind()))
+			if b == nil || t.Id() == "" {
+				continue
+			}
+			if err := b.Delete([]byte(t.Id())); err != nil {
+				return err
+			}
+			if k, _ := b.Cursor().First(); k == nil {
+				if err := root.DeleteBucket([]byte(t.Kind())); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Walk implements names.TagStore.Walk. The walk happens within a
+// single read transaction, so fn must not modify the store.
+func (s *Store) Walk(kind, idPrefix string, fn func(names.Tag) error) error {
+	return s.view(kind, func(kind string, b *bolt.Bucket) error {
+		prefix := []byte(idPrefix)
+		c := b.Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			t, err := names.TagFromKindId(kind, string(k))
+			if err != nil {
+				return fmt.Errorf("invalid stored tag: %v", err)
+			}
+			if err := fn(t); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Count implements names.TagStore.Count.
+func (s *Store) Count(kind, idPrefix string) (int, error) {
+	n := 0
+	err := s.view(kind, func(kind string, b *bolt.Bucket) error {
+		if idPrefix == "" {
+			n += b.Stats().KeyN
+			return nil
+		}
+		prefix := []byte(idPrefix)
+		c := b.Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// view calls fn, in order of kind, with the bucket for each kind of
+// tag matching kind within a read transaction. If kind is empty, all
+// kinds match.
+func (s *Store) view(kind string, fn func(kind string, b *bolt.Bucket) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(rootBucket)
+		if kind != "" {
+			b := root.Bucket([]byte(kind))
+			if b == nil {
+				return nil
+			}
+			return fn(kind, b)
+		}
+		return root.ForEach(func(k, v []byte) error {
+			if v != nil {
+				return nil
+			}
+			return fn(string(k), root.Bucket(k))
+		})
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namesbolt_test
+
+import (
+	"errors"
+	"path/filepath"
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+	"github.com/juju/names/namesbolt"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type namesboltSuite struct {
+	path  string
+	store *namesbolt.Store
+}
+
+var _ = gc.Suite(&namesboltSuite{})
+
+func (s *namesboltSuite) SetUpTest(c *gc.C) {
+	s.path = filepath.Join(c.MkDir(), "tags.db")
+	store, err := namesbolt.Open(s.path)
+	c.Assert(err, gc.IsNil)
+	s.store = store
+}
+
+func (s *namesboltSuite) TearDownTest(c *gc.C) {
+	if s.store != nil {
+		c.Check(s.store.Close(), gc.IsNil)
+	}
+}
+
+func (s *namesboltSuite) walkAll(c *gc.C, kind, idPrefix string) []names.Tag {
+	var tags []names.Tag
+	err := s.store.Walk(kind, idPrefix, func(t names.Tag) error {
+		tags = append(tags, t)
+		return nil
+	})
+	c.Assert(err, gc.IsNil)
+	return tags
+}
+
+func (s *namesboltSuite) TestStore(c *gc.C) {
+	c.Check(s.walkAll(c, "", ""), gc.HasLen, 0)
+
+	err := s.store.Add(
+		names.NewUnitTag("mysql/1"),
+		names.NewMachineTag("0"),
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("wordpress/0"),
+		names.NewMachineTag("0/lxc/0"),
+		names.NewUnitTag("mysql/0"),
+	)
+	c.Assert(err, gc.IsNil)
+
+	c.Check(s.walkAll(c, "", ""), gc.DeepEquals, []names.Tag{
+		names.NewMachineTag("0"),
+		names.NewMachineTag("0/lxc/0"),
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("mysql/1"),
+		names.NewUnitTag("wordpress/0"),
+	})
+	c.Check(s.walkAll(c, names.UnitTagKind, "mysql/"), gc.DeepEquals, []names.Tag{
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("mysql/1"),
+	})
+	c.Check(s.walkAll(c, names.ServiceTagKind, ""), gc.HasLen, 0)
+
+	for _, test := range []struct {
+		kind, idPrefix string
+		count          int
+	}{
+		{"", "", 5},
+		{names.UnitTagKind, "", 3},
+		{names.UnitTagKind, "mysql/", 2},
+		{"", "0", 2},
+		{names.ServiceTagKind, "", 0},
+	} {
+		n, err := s.store.Count(test.kind, test.idPrefix)
+		c.Assert(err, gc.IsNil)
+		c.Check(n, gc.Equals, test.count, gc.Commentf("%q %q", test.kind, test.idPrefix))
+	}
+
+	err = s.store.Remove(names.NewUnitTag("mysql/0"), names.NewMachineTag("0/lxc/0"), names.NewServiceTag("mysql"))
+	c.Assert(err, gc.IsNil)
+	c.Check(s.walkAll(c, "", ""), gc.DeepEquals, []names.Tag{
+		names.NewMachineTag("0"),
+		names.NewUnitTag("mysql/1"),
+		names.NewUnitTag("wordpress/0"),
+	})
+}
+
+func (s *namesboltSuite) TestReopen(c *gc.C) {
+	err := s.store.Add(names.NewUserTag("bob"), names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+	c.Assert(err, gc.IsNil)
+	c.Assert(s.store.Close(), gc.IsNil)
+
+	s.store, err = namesbolt.Open(s.path)
+	c.Assert(err, gc.IsNil)
+	c.Check(s.walkAll(c, "", ""), gc.DeepEquals, []names.Tag{
+		names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+		names.NewUserTag("bob"),
+	})
+}
+
+func (s *namesboltSuite) TestRemoveEmptiesKind(c *gc.C) {
+	tag := names.NewServiceTag("mysql")
+	c.Assert(s.store.Add(tag), gc.IsNil)
+	c.Assert(s.store.Remove(tag), gc.IsNil)
+	c.Assert(s.store.Remove(tag), gc.IsNil)
+	n, err := s.store.Count(names.ServiceTagKind, "")
+	c.Assert(err, gc.IsNil)
+	c.Check(n, gc.Equals, 0)
+}
+
+func (s *namesboltSuite) TestWalkError(c *gc.C) {
+	c.Assert(s.store.Add(names.NewMachineTag("0"), names.NewMachineTag("1")), gc.IsNil)
+	visited := 0
+	err := s.store.Walk("", "", func(names.Tag) error {
+		visited++
+		return errors.New("stop")
+	})
+	c.Check(err, gc.ErrorMatches, "stop")
+	c.Check(visited, gc.Equals, 1)
+}
@@ -0,0 +1,56 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package namesfb provides FlatBuffers encoding of Juju entity tags,
+// using the schema in tag.fbs.
+//
+// Encoded tags can be inspected in place through the Tag accessor
+// type, without allocating; converting to a names.Tag validates the
+// encoded kind and id.
+package namesfb
+
+import (
+	"fmt"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"github.com/juju/names"
+)
+
+// Build adds a Tag table for t to builder and returns its offset, for
+// embedding tags in larger messages.
+func Build(builder *flatbuffers.Builder, t names.Tag) flatbuffers.UOffsetT {
+	kind := builder.CreateString(t.Kind())
+	id := builder.CreateString(t.Id())
+	TagStart(builder)
+	TagAddKind(builder, kind)
+	TagAddId(builder, id)
+	return TagEnd(builder)
+}
+
+// Marshal returns a finished FlatBuffers buffer holding t.
+func Marshal(t names.Tag) []byte {
+	builder := flatbuffers.NewBuilder(64)
+	builder.Finish(Build(builder, t))
+	return builder.FinishedBytes()
+}
+
+// Unmarshal returns the tag held in the finished buffer buf.
+func Unmarshal(buf []byte) (t names.Tag, err error) {
+	if len(buf) < flatbuffers.SizeUOffsetT {
+		return nil, fmt.Errorf("flatbuffers tag too short")
+	}
+	// The flatbuffers runtime does not bounds-check offsets read
+	// from the buffer, so a corrupt buffer causes a panic.
+	defer func() {
+		if recover() != nil {
+			t, err = nil, fmt.Errorf("invalid flatbuffers tag")
+		}
+	}()
+	return ToTag(GetRootAsTag(buf, 0))
+}
+
+// ToTag returns the names.Tag held in the table fb.
+func ToTag(fb *Tag) (names.Tag, error) {
+	return names.TagFromKindId(string(fb.Kind()), string(fb.Id()))
+}
@@ -0,0 +1,72 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namesfb_test
+
+import (
+	stdtesting "testing"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+	"github.com/juju/names/namesfb"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type namesfbSuite struct{}
+
+var _ = gc.Suite(&namesfbSuite{})
+
+var roundTripTags = []names.Tag{
+	names.NewUnitTag("rabbitmq-server/0"),
+	names.NewMachineTag("0/lxc/1"),
+	names.NewServiceTag("wordpress"),
+	names.NewUserTag("bob@local"),
+	names.NewRelationTag("wordpress:db mysql:server"),
+	names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+	names.NewNetworkTag("eth0"),
+	names.JoinActionTag("mysql/0", 3),
+}
+
+func (s *namesfbSuite) TestRoundTrip(c *gc.C) {
+	for i, tag := range roundTripTags {
+		c.Logf("test %d: %s", i, tag)
+		buf := namesfb.Marshal(tag)
+		fb := namesfb.GetRootAsTag(buf, 0)
+		c.Check(string(fb.Kind()), gc.Equals, tag.Kind())
+		c.Check(string(fb.Id()), gc.Equals, tag.Id())
+		got, err := namesfb.Unmarshal(buf)
+		c.Check(err, gc.IsNil)
+		c.Check(got, gc.Equals, tag)
+	}
+}
+
+func (s *namesfbSuite) TestBuildEmbedded(c *gc.C) {
+	builder := flatbuffers.NewBuilder(0)
+	offset := namesfb.Build(builder, names.NewMachineTag("42"))
+	builder.Finish(offset)
+	got, err := namesfb.ToTag(namesfb.GetRootAsTag(builder.FinishedBytes(), 0))
+	c.Check(err, gc.IsNil)
+	c.Check(got, gc.Equals, names.NewMachineTag("42"))
+}
+
+func (s *namesfbSuite) TestUnmarshalInvalid(c *gc.C) {
+	builder := flatbuffers.NewBuilder(0)
+	kind := builder.CreateString("unit")
+	id := builder.CreateString("mysql")
+	namesfb.TagStart(builder)
+	namesfb.TagAddKind(builder, kind)
+	namesfb.TagAddId(builder, id)
+	builder.Finish(namesfb.TagEnd(builder))
+	_, err := namesfb.Unmarshal(builder.FinishedBytes())
+	c.Check(err, gc.ErrorMatches, `"mysql" is not a valid unit id`)
+
+	_, err = namesfb.Unmarshal([]byte{1})
+	c.Check(err, gc.ErrorMatches, `flatbuffers tag too short`)
+	_, err = namesfb.Unmarshal([]byte{0xff, 0xff, 0xff, 0x0f})
+	c.Check(err, gc.ErrorMatches, `invalid flatbuffers tag`)
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namesfb
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// The accessors below follow the code that flatc generates for the Tag
+// table in tag.fbs; keep the two in step.
+
+// Tag provides access to an encoded Tag table.
+type Tag struct {
+	_tab flatbuffers.Table
+}
+
+// GetRootAsTag returns the Tag table at the root of buf.
+func GetRootAsTag(buf []byte, offset flatbuffers.UOffsetT) *Tag {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &Tag{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+// Init initialises rcv to read the table at position i of buf.
+func (rcv *Tag) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+// Table returns the underlying table.
+func (rcv *Tag) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+// Kind returns the kind field. The returned slice refers to the
+// encoded buffer.
+func (rcv *Tag) Kind() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+// Id returns the id field. The returned slice refers to the encoded
+// buffer.
+func (rcv *Tag) Id() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+// TagStart starts building a Tag table.
+func TagStart(builder *flatbuffers.Builder) {
+	builder.StartObject(2)
+}
+
+// TagAddKind adds the kind field to the Tag table being built.
+func TagAddKind(builder *flatbuffers.Builder, kind flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(kind), 0)
+}
+
+// TagAddId adds the id field to the Tag table being built.
+func TagAddId(builder *flatbuffers.Builder, id flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(id), 0)
+}
+
+// TagEnd finishes building a Tag table and returns its offset.
+func TagEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
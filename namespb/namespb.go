@@ -0,0 +1,45 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package namespb provides a Protocol Buffers message for Juju entity
+// tags, defined in tag.proto, and conversions between it and
+// names.Tag.
+package namespb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative tag.proto
+
+import (
+	"fmt"
+
+	"github.com/juju/names"
+)
+
+// ToProto returns the message holding t, or nil if t is nil.
+func ToProto(t names.Tag) *Tag {
+	if t == nil {
+		return nil
+	}
+	return &Tag{Kind: t.Kind(), Id: t.Id()}
+}
+
+// FromProto returns the tag held in the message m, validating its kind
+// and id.
+func FromProto(m *Tag) (names.Tag, error) {
+	if m == nil {
+		return nil, fmt.Errorf("no tag in message")
+	}
+	return names.TagFromKindId(m.GetKind(), m.GetId())
+}
+
+// FromProtoKind is like FromProto but also requires the tag to be of
+// the given kind.
+func FromProtoKind(m *Tag, kind string) (names.Tag, error) {
+	t, err := FromProto(m)
+	if err != nil {
+		return nil, err
+	}
+	if t.Kind() != kind {
+		return nil, fmt.Errorf("%q is not a %s tag", t, kind)
+	}
+	return t, nil
+}
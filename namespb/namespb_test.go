@@ -0,0 +1,70 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package namespb_test
+
+import (
+	stdtesting "testing"
+
+	"google.golang.org/protobuf/proto"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+	"github.com/juju/names/namespb"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type namespbSuite struct{}
+
+var _ = gc.Suite(&namespbSuite{})
+
+var protoTags = []names.Tag{
+	names.NewUnitTag("wordpress-site/12"),
+	names.NewMachineTag("0/lxc/1"),
+	names.NewServiceTag("mysql"),
+	names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+	names.NewUserTag("bob@remote"),
+	names.NewRelationTag("wordpress:db mysql:server"),
+	names.NewNetworkTag("net1"),
+	names.NewActionTag("mysql/0" + names.ActionMarker + "3"),
+	names.NewActionResultTag("mysql/0" + names.ActionResultMarker + "3"),
+}
+
+func (s *namespbSuite) TestRoundTrip(c *gc.C) {
+	for i, tag := range protoTags {
+		c.Logf("test %d: %s", i, tag)
+		m := namespb.ToProto(tag)
+		c.Check(m.Kind, gc.Equals, tag.Kind())
+		c.Check(m.Id, gc.Equals, tag.Id())
+
+		data, err := proto.Marshal(m)
+		c.Assert(err, gc.IsNil)
+		var decoded namespb.Tag
+		c.Assert(proto.Unmarshal(data, &decoded), gc.IsNil)
+		t, err := namespb.FromProto(&decoded)
+		c.Assert(err, gc.IsNil)
+		c.Check(t, gc.Equals, tag)
+	}
+}
+
+func (s *namespbSuite) TestInvalid(c *gc.C) {
+	c.Check(namespb.ToProto(nil), gc.IsNil)
+	_, err := namespb.FromProto(nil)
+	c.Check(err, gc.ErrorMatches, `no tag in message`)
+	_, err = namespb.FromProto(&namespb.Tag{Kind: "unit", Id: "mysql"})
+	c.Check(err, gc.ErrorMatches, `"mysql" is not a valid unit id`)
+	_, err = namespb.FromProto(&namespb.Tag{Kind: "foo", Id: "bar"})
+	c.Check(err, gc.ErrorMatches, `"foo" is not a valid tag kind`)
+}
+
+func (s *namespbSuite) TestFromProtoKind(c *gc.C) {
+	m := namespb.ToProto(names.NewUnitTag("mysql/0"))
+	t, err := namespb.FromProtoKind(m, names.UnitTagKind)
+	c.Assert(err, gc.IsNil)
+	c.Check(t, gc.Equals, names.NewUnitTag("mysql/0"))
+	_, err = namespb.FromProtoKind(m, names.MachineTagKind)
+	c.Check(err, gc.ErrorMatches, `"unit-mysql-0" is not a machine tag`)
+}
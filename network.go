@@ -5,7 +5,6 @@ package names
 
 import (
 	"fmt"
-	"regexp"
 )
 
 const NetworkTagKind = "network"
@@ -14,11 +13,9 @@ const (
 	NetworkSnippet = "(?:[a-z0-9]+(?:-[a-z0-9]+)*)"
 )
 
-var validNetwork = regexp.MustCompile("^" + NetworkSnippet + "$")
-
 // IsValidNetwork reports whether name is a valid network name.
 func IsValidNetwork(name string) bool {
-	return validNetwork.MatchString(name)
+	return matchNetwork(name)
 }
 
 type NetworkTag struct {
@@ -29,6 +26,12 @@ func (t NetworkTag) String() string { return t.Kind() + "-" + t.Id() }
 func (t NetworkTag) Kind() string   { return NetworkTagKind }
 func (t NetworkTag) Id() string     { return t.name }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t NetworkTag) AppendString(dst []byte) []byte {
+	return append(append(dst, NetworkTagKind+KindSeparator...), t.name...)
+}
+
 // NewNetworkTag returns the tag of a network with the given name.
 func NewNetworkTag(name string) NetworkTag {
 	if !IsValidNetwork(name) {
@@ -0,0 +1,121 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const OperationTagKind = "operation"
+
+// IsValidOperation returns whether id is a valid operation id: either
+// a number without leading zeros, such as "42", or a UUID.
+func IsValidOperation(id string) bool {
+	if _, ok := parseUUID(id); ok {
+		return true
+	}
+	return matchNumber(id)
+}
+
+// OperationTag represents an operation: a batch of actions enqueued
+// together, such as the same action run on several units.
+type OperationTag struct {
+	id string
+}
+
+func (t OperationTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t OperationTag) Kind() string   { return OperationTagKind }
+func (t OperationTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t OperationTag) AppendString(dst []byte) []byte {
+	return append(append(dst, OperationTagKind+KindSeparator...), t.id...)
+}
+
+// NewOperationTag returns the tag of the operation with the given id.
+// It will panic if the given id is not valid.
+func NewOperationTag(id string) OperationTag {
+	if !IsValidOperation(id) {
+		panic(fmt.Sprintf("%q is not a valid operation id", id))
+	}
+	return OperationTag{id: id}
+}
+
+// ParseOperationTag parses an operation tag string.
+func ParseOperationTag(operationTag string) (OperationTag, error) {
+	tag, err := ParseTag(operationTag)
+	if err != nil {
+		return OperationTag{}, err
+	}
+	ot, ok := tag.(OperationTag)
+	if !ok {
+		return OperationTag{}, invalidTagError(operationTag, OperationTagKind)
+	}
+	return ot, nil
+}
+
+// Operation records the actions that make up an operation. Action ids
+// do not record the operation they belong to, so the relationship is
+// held here instead.
+type Operation struct {
+	tag     OperationTag
+	actions []ActionTag
+}
+
+// NewOperation returns the record of the operation with the given tag,
+// made up of the given actions. It returns an error if the operation
+// tag is the zero value, or if any action is invalid or appears more
+// than once.
+func NewOperation(tag OperationTag, actions []ActionTag) (Operation, error) {
+	if tag == (OperationTag{}) {
+		return Operation{}, fmt.Errorf("no operation tag")
+	}
+	seen := make(map[ActionTag]bool)
+	for _, a := range actions {
+		if !IsValidAction(a.Id()) {
+			return Operation{}, fmt.Errorf("%q is not a valid action tag", a.String())
+		}
+		if seen[a] {
+			return Operation{}, fmt.Errorf("action %q appears more than once in %q", a.Id(), tag.Id())
+		}
+		seen[a] = true
+	}
+	return Operation{tag: tag, actions: append([]ActionTag(nil), actions...)}, nil
+}
+
+// Tag returns the tag of the operation.
+func (o Operation) Tag() OperationTag { return o.tag }
+
+// Actions returns the tags of the operation's actions, in the order
+// in which they were enqueued.
+func (o Operation) Actions() []ActionTag {
+	return append([]ActionTag(nil), o.actions...)
+}
+
+// Contains returns whether the given action is part of the operation.
+func (o Operation) Contains(action ActionTag) bool {
+	for _, a := range o.actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// Receivers returns the tags of the units and services on which the
+// operation's actions run, each once, in the order in which their
+// first action was enqueued.
+func (o Operation) Receivers() []Tag {
+	var receivers []Tag
+	seen := make(map[Tag]bool)
+	for _, a := range o.actions {
+		r := a.PrefixTag()
+		if r != nil && !seen[r] {
+			seen[r] = true
+			receivers = append(receivers, r)
+		}
+	}
+	return receivers
+}
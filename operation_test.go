@@ -0,0 +1,125 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type operationSuite struct{}
+
+var _ = gc.Suite(&operationSuite{})
+
+var operationIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "0", valid: true},
+	{id: "42", valid: true},
+	{id: "f47ac10b-58cc-4372-a567-0e02b2c3d479", valid: true},
+	{id: ""},
+	{id: "042"},
+	{id: "-1"},
+	{id: "f47ac10b"},
+	{id: "xf47ac10b-58cc-4372-a567-0e02b2c3d479"},
+	{id: "mysql/0_a_1"},
+}
+
+func (s *operationSuite) TestOperationTag(c *gc.C) {
+	for i, test := range operationIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidOperation(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid operation id", test.id)
+			testTag := func() { names.NewOperationTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewOperationTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, "operation-"+test.id)
+	}
+}
+
+var parseOperationTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "operation-1",
+	expected: names.NewOperationTag("1"),
+}, {
+	tag:      "operation-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewOperationTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "operation-01",
+	err: names.InvalidTagError("operation-01", names.OperationTagKind),
+}, {
+	tag: "action-mysql/0_a_1",
+	err: names.InvalidTagError("action-mysql/0_a_1", names.OperationTagKind),
+}}
+
+func (s *operationSuite) TestParseOperationTag(c *gc.C) {
+	for i, t := range parseOperationTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseOperationTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+func (s *operationSuite) TestOperation(c *gc.C) {
+	tag := names.NewOperationTag("7")
+	actions := []names.ActionTag{
+		names.JoinActionTag("mysql/0", 3),
+		names.JoinActionTag("mysql/1", 0),
+		names.JoinActionTag("mysql/0", 4),
+		names.JoinActionTag("wordpress", 1),
+	}
+	op, err := names.NewOperation(tag, actions)
+	c.Assert(err, gc.IsNil)
+	c.Check(op.Tag(), gc.Equals, tag)
+	c.Check(op.Actions(), gc.DeepEquals, actions)
+	c.Check(op.Contains(names.JoinActionTag("mysql/1", 0)), gc.Equals, true)
+	c.Check(op.Contains(names.JoinActionTag("mysql/1", 1)), gc.Equals, false)
+	c.Check(op.Receivers(), gc.DeepEquals, []names.Tag{
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("mysql/1"),
+		names.NewServiceTag("wordpress"),
+	})
+
+	// The operation does not share the caller's slice.
+	actions[0] = names.JoinActionTag("mysql/2", 0)
+	c.Check(op.Contains(names.JoinActionTag("mysql/0", 3)), gc.Equals, true)
+	op.Actions()[0] = names.JoinActionTag("mysql/2", 0)
+	c.Check(op.Actions()[0], gc.Equals, names.JoinActionTag("mysql/0", 3))
+
+	empty, err := names.NewOperation(tag, nil)
+	c.Assert(err, gc.IsNil)
+	c.Check(empty.Actions(), gc.HasLen, 0)
+	c.Check(empty.Receivers(), gc.HasLen, 0)
+}
+
+func (s *operationSuite) TestNewOperationErrors(c *gc.C) {
+	tag := names.NewOperationTag("7")
+	_, err := names.NewOperation(names.OperationTag{}, nil)
+	c.Check(err, gc.ErrorMatches, `no operation tag`)
+	_, err = names.NewOperation(tag, []names.ActionTag{{}})
+	c.Check(err, gc.ErrorMatches, `"-" is not a valid action tag`)
+	a := names.JoinActionTag("mysql/0", 1)
+	_, err = names.NewOperation(tag, []names.ActionTag{a, a})
+	c.Check(err, gc.ErrorMatches, `action "mysql/0_a_1" appears more than once in "7"`)
+}
@@ -0,0 +1,58 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Owned pairs an entity with the user that owns it, for recording
+// ownership such as "bob owns environment X".
+type Owned struct {
+	owner  UserTag
+	entity Tag
+}
+
+// NewOwned returns the record of owner owning entity. The owner's
+// provider is made explicit, so that "bob" and "bob@local" make the
+// same record.
+func NewOwned(owner UserTag, entity Tag) Owned {
+	return Owned{
+		owner:  UserTag{name: owner.Name(), provider: owner.Provider()},
+		entity: entity,
+	}
+}
+
+// Owner returns the tag of the owning user.
+func (o Owned) Owner() UserTag { return o.owner }
+
+// Entity returns the tag of the owned entity.
+func (o Owned) Entity() Tag { return o.entity }
+
+// String returns the canonical encoding of the ownership record: the
+// owner and entity tags separated by a colon, such as
+// "user-bob@local:service-wordpress".
+func (o Owned) String() string {
+	return o.owner.String() + ":" + o.entity.String()
+}
+
+// ParseOwned parses the canonical encoding of an ownership record, as
+// returned by Owned.String.
+func ParseOwned(s string) (Owned, error) {
+	// User tags never contain a colon, although entity tags may.
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return Owned{}, fmt.Errorf("%q is not a valid ownership record", s)
+	}
+	owner, err := ParseUserTag(s[:i])
+	if err != nil {
+		return Owned{}, fmt.Errorf("%q is not a valid ownership record: %v", s, err)
+	}
+	entity, err := ParseTag(s[i+1:])
+	if err != nil {
+		return Owned{}, fmt.Errorf("%q is not a valid ownership record: %v", s, err)
+	}
+	return NewOwned(owner, entity), nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type ownedSuite struct{}
+
+var _ = gc.Suite(&ownedSuite{})
+
+func (s *ownedSuite) TestOwned(c *gc.C) {
+	env := names.NewEnvironTag("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	owned := names.NewOwned(names.NewUserTag("bob"), env)
+	c.Check(owned.Owner(), gc.Equals, names.NewUserTag("bob@local"))
+	c.Check(owned.Entity(), gc.Equals, env)
+	c.Check(owned.String(), gc.Equals, "user-bob@local:environment-f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	c.Check(names.NewOwned(names.NewUserTag("bob@local"), env), gc.Equals, owned)
+}
+
+func (s *ownedSuite) TestParseOwned(c *gc.C) {
+	for i, test := range []struct {
+		input  string
+		owner  names.UserTag
+		entity names.Tag
+		err    string
+	}{{
+		input:  "user-bob@local:service-wordpress",
+		owner:  names.NewUserTag("bob@local"),
+		entity: names.NewServiceTag("wordpress"),
+	}, {
+		input:  "user-alice:relation-wordpress.db#mysql.server",
+		owner:  names.NewUserTag("alice@local"),
+		entity: names.NewRelationTag("wordpress:db mysql:server"),
+	}, {
+		input:  "user-alice@external:machine-0-lxc-1",
+		owner:  names.NewUserTag("alice@external"),
+		entity: names.NewMachineTag("0/lxc/1"),
+	}, {
+		input: "user-bob",
+		err:   `"user-bob" is not a valid ownership record`,
+	}, {
+		input: "service-mysql:user-bob",
+		err:   `"service-mysql:user-bob" is not a valid ownership record: "service-mysql" is not a valid user tag`,
+	}, {
+		input: "user-bob:unit-mysql",
+		err:   `"user-bob:unit-mysql" is not a valid ownership record: "unit-mysql" is not a valid unit tag`,
+	}} {
+		c.Logf("test %d: %s", i, test.input)
+		owned, err := names.ParseOwned(test.input)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+		c.Check(owned.Owner(), gc.Equals, test.owner)
+		c.Check(owned.Entity(), gc.Equals, test.entity)
+		again, err := names.ParseOwned(owned.String())
+		c.Check(err, gc.IsNil)
+		c.Check(again, gc.Equals, owned)
+	}
+}
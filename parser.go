@@ -0,0 +1,98 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// defaultParser holds the parser set by SetDefaultParser, or nil if
+// ParseTag applies no hooks or relaxed rules.
+var defaultParser atomic.Pointer[Parser]
+
+// SetDefaultParser sets the parser used by ParseTag, and so by every
+// Parse*Tag function, and returns the previous default. Tags that the
+// package derives or decodes itself, such as by TagFromKindId and by
+// the decoding methods of the tag types, are parsed without it.
+// Setting the zero Parser restores the behaviour of ParseTag. Hooks
+// are run for every tag parsed, so they
+// must not themselves call ParseTag, or any function that does, lest
+// they recurse; they may call the ParseTag method of another Parser.
+func SetDefaultParser(p Parser) Parser {
+	var prev *Parser
+	if p.FoldKind || len(p.Before) > 0 || len(p.After) > 0 {
+		prev = defaultParser.Swap(&p)
+	} else {
+		prev = defaultParser.Swap(nil)
+	}
+	if prev == nil {
+		return Parser{}
+	}
+	return *prev
+}
+
+// Parser parses tags, optionally relaxing the rules applied by
+// ParseTag. The zero Parser behaves exactly like ParseTag.
+type Parser struct {
+	// FoldKind specifies that the kind of a tag is matched without
+	// regard to case, so that "Unit-mysql-0" is parsed as the tag of
+	// unit "mysql/0". The case of the id is unaffected.
+	FoldKind bool
+
+	// Before holds hooks run in order before a tag is parsed, such as
+	// to normalize input or to enforce a policy. Each is given the
+	// result of the previous one, and the last result is parsed. If
+	// a hook returns an error, parsing stops and ParseTag returns it.
+	Before []func(tag string) (string, error)
+
+	// After holds hooks run in order after a tag is parsed, whether
+	// or not parsing succeeded, such as to audit or to further
+	// restrict the tags accepted. Each is given the tag string as
+	// passed to ParseTag and the result of the previous one, and
+	// ParseTag returns the result of the last. If that holds neither
+	// a tag nor an error, ParseTag returns an error.
+	After []func(tag string, t Tag, err error) (Tag, error)
+}
+
+// ParseTag parses a string representation into a Tag, ignoring any
+// default parser.
+func (p Parser) ParseTag(tag string) (Tag, error) {
+	input := tag
+	for _, hook := range p.Before {
+		var err error
+		if tag, err = hook(tag); err != nil {
+			return nil, err
+		}
+	}
+	t, err := p.parse(tag)
+	for _, hook := range p.After {
+		t, err = hook(input, t, err)
+	}
+	if t == nil && err == nil {
+		return nil, fmt.Errorf("%q is not a valid tag: no tag returned by parse hooks", input)
+	}
+	return t, err
+}
+
+func (p Parser) parse(tag string) (Tag, error) {
+	if !p.FoldKind {
+		return parseTag(tag)
+	}
+	i := strings.Index(tag, KindSeparator)
+	if i <= 0 {
+		return nil, invalidTagError(tag, "")
+	}
+	kind := strings.ToLower(tag[:i])
+	t, err := parseTag(kind + tag[i:])
+	if err != nil {
+		// Report the tag as given.
+		if !validKinds(kind) {
+			kind = ""
+		}
+		return nil, invalidTagError(tag, kind)
+	}
+	return t, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type parserSuite struct{}
+
+var _ = gc.Suite(&parserSuite{})
+
+func (s *parserSuite) TestZeroParser(c *gc.C) {
+	for i, test := range parseTagTests {
+		c.Logf("test %d: %s", i, test.tag)
+		expect, expectErr := names.ParseTag(test.tag)
+		tag, err := names.Parser{}.ParseTag(test.tag)
+		c.Check(tag, gc.Equals, expect)
+		c.Check(err, gc.DeepEquals, expectErr)
+	}
+	_, err := names.Parser{}.ParseTag("Unit-mysql-0")
+	c.Check(err, gc.ErrorMatches, `"Unit-mysql-0" is not a valid tag`)
+}
+
+func (s *parserSuite) TestFoldKind(c *gc.C) {
+	p := names.Parser{FoldKind: true}
+	for i, test := range []struct {
+		tag    string
+		expect names.Tag
+		err    string
+	}{
+		{tag: "unit-mysql-0", expect: names.NewUnitTag("mysql/0")},
+		{tag: "Unit-mysql-0", expect: names.NewUnitTag("mysql/0")},
+		{tag: "MACHINE-0-lxc-1", expect: names.NewMachineTag("0/lxc/1")},
+		{tag: "User-Bob@Local", expect: names.NewUserTag("Bob@Local")},
+		{tag: "Service-MySQL", err: `"Service-MySQL" is not a valid service tag`},
+		{tag: "Unit-mysql", err: `"Unit-mysql" is not a valid unit tag`},
+		{tag: "Foo-bar", err: `"Foo-bar" is not a valid tag`},
+		{tag: "Unit", err: `"Unit" is not a valid tag`},
+	} {
+		c.Logf("test %d: %s", i, test.tag)
+		tag, err := p.ParseTag(test.tag)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Check(err, gc.IsNil)
+		c.Check(tag, gc.Equals, test.expect)
+	}
+}
+
+func (s *parserSuite) TestHooks(c *gc.C) {
+	var calls []string
+	p := names.Parser{
+		FoldKind: true,
+		Before: []func(string) (string, error){
+			func(tag string) (string, error) {
+				calls = append(calls, "trim "+tag)
+				return strings.TrimSpace(tag), nil
+			},
+			func(tag string) (string, error) {
+				calls = append(calls, "deny "+tag)
+				if strings.HasPrefix(tag, "user-") {
+					return "", fmt.Errorf("user tags not allowed")
+				}
+				return tag, nil
+			},
+		},
+		After: []func(string, names.Tag, error) (names.Tag, error){
+			func(tag string, t names.Tag, err error) (names.Tag, error) {
+				calls = append(calls, fmt.Sprintf("audit %q %v %v", tag, t, err))
+				return t, err
+			},
+			func(tag string, t names.Tag, err error) (names.Tag, error) {
+				if t, ok := t.(names.UnitTag); ok && t.Id() == "mysql/0" {
+					return nil, fmt.Errorf("unit %s is reserved", t.Id())
+				}
+				return t, err
+			},
+		},
+	}
+
+	tag, err := p.ParseTag(" Unit-mysql-1 ")
+	c.Assert(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/1"))
+	c.Check(calls, gc.DeepEquals, []string{
+		`trim  Unit-mysql-1 `,
+		`deny Unit-mysql-1`,
+		`audit " Unit-mysql-1 " unit-mysql-1 <nil>`,
+	})
+
+	calls = nil
+	_, err = p.ParseTag("unit-mysql")
+	c.Check(err, gc.ErrorMatches, `"unit-mysql" is not a valid unit tag`)
+	c.Check(calls[2], gc.Equals, `audit "unit-mysql" <nil> "unit-mysql" is not a valid unit tag`)
+
+	_, err = p.ParseTag("unit-mysql-0")
+	c.Check(err, gc.ErrorMatches, `unit mysql/0 is reserved`)
+
+	calls = nil
+	_, err = p.ParseTag("user-bob")
+	c.Check(err, gc.ErrorMatches, `user tags not allowed`)
+	c.Check(calls, gc.HasLen, 2)
+}
+
+func (s *parserSuite) TestAfterHookReturnsNothing(c *gc.C) {
+	p := names.Parser{
+		After: []func(string, names.Tag, error) (names.Tag, error){
+			func(string, names.Tag, error) (names.Tag, error) { return nil, nil },
+		},
+	}
+	tag, err := p.ParseTag("unit-mysql-0")
+	c.Check(tag, gc.IsNil)
+	c.Check(err, gc.ErrorMatches, `"unit-mysql-0" is not a valid tag: no tag returned by parse hooks`)
+
+	prev := names.SetDefaultParser(p)
+	defer names.SetDefaultParser(prev)
+	_, err = names.ParseTag("unit-mysql-0")
+	c.Check(err, gc.ErrorMatches, `"unit-mysql-0" is not a valid tag: no tag returned by parse hooks`)
+	tag, err = names.TagFromKindId(names.UnitTagKind, "mysql/0")
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/0"))
+}
+
+func (s *parserSuite) TestDefaultParserNormalizing(c *gc.C) {
+	// A hook that rewrites every unit tag must not affect the
+	// package's own round trips.
+	prev := names.SetDefaultParser(names.Parser{
+		Before: []func(string) (string, error){
+			func(string) (string, error) { return "unit-other-1", nil },
+		},
+	})
+	defer names.SetDefaultParser(prev)
+
+	tag, err := names.ParseTag("unit-mysql-0")
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("other/1"))
+
+	tag, err = names.TagFromKindId(names.UnitTagKind, "mysql/0")
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/0"))
+	data, err := json.Marshal(names.NewUnitTag("mysql/0"))
+	c.Assert(err, gc.IsNil)
+	var unit names.UnitTag
+	c.Assert(json.Unmarshal(data, &unit), gc.IsNil)
+	c.Check(unit, gc.Equals, names.NewUnitTag("mysql/0"))
+}
+
+func (s *parserSuite) TestSetDefaultParser(c *gc.C) {
+	var audited []string
+	prev := names.SetDefaultParser(names.Parser{
+		FoldKind: true,
+		After: []func(string, names.Tag, error) (names.Tag, error){
+			func(tag string, t names.Tag, err error) (names.Tag, error) {
+				audited = append(audited, tag)
+				if t, ok := t.(names.UnitTag); ok && t.Id() == "mysql/0" {
+					return nil, fmt.Errorf("unit %s is reserved", t.Id())
+				}
+				return t, err
+			},
+		},
+	})
+	defer names.SetDefaultParser(prev)
+	c.Check(prev.FoldKind, gc.Equals, false)
+
+	tag, err := names.ParseTag("Unit-mysql-1")
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/1"))
+	unit, err := names.ParseUnitTag("UNIT-mysql-2")
+	c.Check(err, gc.IsNil)
+	c.Check(unit, gc.Equals, names.NewUnitTag("mysql/2"))
+	_, err = names.ParseUnitTag("unit-mysql-0")
+	c.Check(err, gc.ErrorMatches, `unit mysql/0 is reserved`)
+	_, err = names.ParseTagBytes([]byte("unit-mysql-0"))
+	c.Check(err, gc.ErrorMatches, `unit mysql/0 is reserved`)
+	c.Check(audited, gc.DeepEquals, []string{
+		"Unit-mysql-1", "UNIT-mysql-2", "unit-mysql-0", "unit-mysql-0",
+	})
+
+	// Tags the package decodes or derives itself bypass the hooks.
+	var decoded names.UnitTag
+	err = decoded.UnmarshalText([]byte("unit-mysql-0"))
+	c.Check(err, gc.IsNil)
+	c.Check(decoded, gc.Equals, names.NewUnitTag("mysql/0"))
+	tag, err = names.TagFromKindId(names.UnitTagKind, "mysql/0")
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/0"))
+	c.Check(audited, gc.HasLen, 4)
+
+	// A Parser's own ParseTag method ignores the default.
+	tag, err = names.Parser{}.ParseTag("unit-mysql-0")
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/0"))
+
+	// Setting the zero Parser restores the default behaviour.
+	prev2 := names.SetDefaultParser(names.Parser{})
+	c.Check(prev2.FoldKind, gc.Equals, true)
+	c.Check(prev2.After, gc.HasLen, 1)
+	_, err = names.ParseTag("Unit-mysql-1")
+	c.Check(err, gc.ErrorMatches, `"Unit-mysql-1" is not a valid tag`)
+	tag, err = names.ParseTag("unit-mysql-0")
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/0"))
+}
@@ -0,0 +1,135 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"strings"
+)
+
+// NamingProfile tightens the validation of names that end up in cloud
+// provider resource names, so that names accepted by Juju do not later
+// fail at the provider. The zero NamingProfile applies no additional
+// constraints.
+type NamingProfile struct {
+	// Name identifies the profile, such as "gce".
+	Name string
+
+	// MaxNameLength holds the maximum length of service and
+	// environment names and of machine tags, as these are embedded
+	// in provider resource names. If it is zero, there is no limit.
+	MaxNameLength int
+
+	// LeadingLetter specifies that names must start with a letter,
+	// as required by RFC 1035 labels.
+	LeadingLetter bool
+
+	// TrailingAlphanumeric specifies that names must end with a
+	// letter or digit rather than a hyphen, as required by RFC 1035
+	// labels.
+	TrailingAlphanumeric bool
+
+	// ReservedPrefixes holds prefixes that names must not start with.
+	ReservedPrefixes []string
+
+	// ReservedWords holds words that names must not contain.
+	ReservedWords []string
+}
+
+// AzureNaming returns the constraints of Azure resource names.
+func AzureNaming() NamingProfile {
+	return NamingProfile{
+		Name:             "azure",
+		MaxNameLength:    64,
+		ReservedPrefixes: []string{"login"},
+		ReservedWords:    []string{"microsoft", "windows"},
+	}
+}
+
+// AWSNaming returns the constraints of AWS tag values. Values must
+// also not start with "aws:", but valid names cannot contain a colon.
+func AWSNaming() NamingProfile {
+	return NamingProfile{
+		Name:          "aws",
+		MaxNameLength: 256,
+	}
+}
+
+// GCENaming returns the constraints of GCE resource names, which must
+// be RFC 1035 labels.
+func GCENaming() NamingProfile {
+	return NamingProfile{
+		Name:                 "gce",
+		MaxNameLength:        63,
+		LeadingLetter:        true,
+		TrailingAlphanumeric: true,
+		ReservedPrefixes:     []string{"goog"},
+		ReservedWords:        []string{"google"},
+	}
+}
+
+// NamingProfileFor returns the naming profile with the given name, if
+// there is one.
+func NamingProfileFor(name string) (NamingProfile, bool) {
+	for _, p := range []NamingProfile{AzureNaming(), AWSNaming(), GCENaming()} {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return NamingProfile{}, false
+}
+
+// IsValidService returns whether name is a valid service name that
+// meets the profile's constraints.
+func (p NamingProfile) IsValidService(name string) bool {
+	return IsValidService(name) && p.allows(name)
+}
+
+// IsValidUnit returns whether name is a valid unit name whose service
+// name meets the profile's constraints.
+func (p NamingProfile) IsValidUnit(name string) bool {
+	return IsValidUnit(name) && p.IsValidService(UnitService(name))
+}
+
+// IsValidMachine returns whether id is a valid machine id whose tag
+// meets the profile's length constraint.
+func (p NamingProfile) IsValidMachine(id string) bool {
+	return IsValidMachine(id) && p.fits(NewMachineTag(id).String())
+}
+
+// IsValidEnvironName returns whether name is a valid environment name
+// that meets the profile's constraints. Environment names consist of
+// lower case letters, digits and hyphens, and do not start with a
+// hyphen.
+func (p NamingProfile) IsValidEnvironName(name string) bool {
+	return matchEnvironName(name) && p.allows(name)
+}
+
+// allows returns whether name meets all the profile's constraints.
+func (p NamingProfile) allows(name string) bool {
+	if !p.fits(name) {
+		return false
+	}
+	if p.LeadingLetter && !(name != "" && name[0] >= 'a' && name[0] <= 'z') {
+		return false
+	}
+	if p.TrailingAlphanumeric && strings.HasSuffix(name, "-") {
+		return false
+	}
+	for _, prefix := range p.ReservedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	for _, word := range p.ReservedWords {
+		if strings.Contains(name, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// fits returns whether name meets the profile's length constraint.
+func (p NamingProfile) fits(name string) bool {
+	return p.MaxNameLength <= 0 || len(name) <= p.MaxNameLength
+}
@@ -0,0 +1,99 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type profileSuite struct{}
+
+var _ = gc.Suite(&profileSuite{})
+
+func (s *profileSuite) TestNamingProfileFor(c *gc.C) {
+	for _, name := range []string{"azure", "aws", "gce"} {
+		p, ok := names.NamingProfileFor(name)
+		c.Check(ok, gc.Equals, true)
+		c.Check(p.Name, gc.Equals, name)
+	}
+	_, ok := names.NamingProfileFor("openstack")
+	c.Check(ok, gc.Equals, false)
+}
+
+func (s *profileSuite) TestZeroProfile(c *gc.C) {
+	var p names.NamingProfile
+	long := strings.Repeat("a", 300)
+	c.Check(p.IsValidService(long), gc.Equals, true)
+	c.Check(p.IsValidService("google-windows"), gc.Equals, true)
+	c.Check(p.IsValidService("Mysql"), gc.Equals, false)
+	c.Check(p.IsValidUnit("mysql/0"), gc.Equals, true)
+	c.Check(p.IsValidMachine("0/lxc/1"), gc.Equals, true)
+	c.Check(p.IsValidEnvironName("1st-env"), gc.Equals, true)
+	c.Check(p.IsValidEnvironName("-env"), gc.Equals, false)
+}
+
+var namingProfileTests = []struct {
+	profile names.NamingProfile
+	valid   []string
+	invalid []string
+}{{
+	profile: names.AzureNaming(),
+	valid:   []string{"mysql", strings.Repeat("a", 64), "blogin"},
+	invalid: []string{strings.Repeat("a", 65), "login-portal", "my-microsoft-sql", "windows"},
+}, {
+	profile: names.AWSNaming(),
+	valid:   []string{"mysql", strings.Repeat("a", 256), "aws-proxy"},
+	invalid: []string{strings.Repeat("a", 257)},
+}, {
+	profile: names.GCENaming(),
+	valid:   []string{"mysql", strings.Repeat("a", 63), "my-goog"},
+	invalid: []string{strings.Repeat("a", 64), "google-proxy", "my-google", "goog"},
+}}
+
+func (s *profileSuite) TestServiceNames(c *gc.C) {
+	for _, test := range namingProfileTests {
+		c.Logf("profile %s", test.profile.Name)
+		for _, name := range test.valid {
+			c.Check(test.profile.IsValidService(name), gc.Equals, true, gc.Commentf("%q", name))
+			c.Check(test.profile.IsValidUnit(name+"/0"), gc.Equals, true, gc.Commentf("%q", name))
+			c.Check(test.profile.IsValidEnvironName(name), gc.Equals, true, gc.Commentf("%q", name))
+		}
+		for _, name := range test.invalid {
+			c.Check(test.profile.IsValidService(name), gc.Equals, false, gc.Commentf("%q", name))
+			c.Check(test.profile.IsValidUnit(name+"/0"), gc.Equals, false, gc.Commentf("%q", name))
+			c.Check(test.profile.IsValidEnvironName(name), gc.Equals, false, gc.Commentf("%q", name))
+		}
+	}
+}
+
+func (s *profileSuite) TestLeadingLetter(c *gc.C) {
+	c.Check(names.GCENaming().IsValidEnvironName("prod"), gc.Equals, true)
+	c.Check(names.GCENaming().IsValidEnvironName("1st-env"), gc.Equals, false)
+	c.Check(names.AzureNaming().IsValidEnvironName("1st-env"), gc.Equals, true)
+}
+
+func (s *profileSuite) TestTrailingAlphanumeric(c *gc.C) {
+	c.Check(names.GCENaming().IsValidEnvironName("prod-1"), gc.Equals, true)
+	c.Check(names.GCENaming().IsValidEnvironName("prod-"), gc.Equals, false)
+	c.Check(names.AzureNaming().IsValidEnvironName("prod-"), gc.Equals, true)
+}
+
+func (s *profileSuite) TestProfilesAreCopies(c *gc.C) {
+	p := names.GCENaming()
+	p.MaxNameLength = 1
+	p.ReservedWords[0] = "mysql"
+	c.Check(names.GCENaming().IsValidService("mysql"), gc.Equals, true)
+}
+
+func (s *profileSuite) TestMachines(c *gc.C) {
+	p := names.NamingProfile{MaxNameLength: len("machine-0-lxc-1")}
+	c.Check(p.IsValidMachine("0/lxc/1"), gc.Equals, true)
+	c.Check(p.IsValidMachine("10/lxc/1"), gc.Equals, false)
+	c.Check(p.IsValidMachine("0/lxc/01"), gc.Equals, false)
+	c.Check(names.GCENaming().IsValidMachine("0/kvm/1/lxc/2"), gc.Equals, true)
+}
@@ -5,7 +5,7 @@ package names
 
 import (
 	"fmt"
-	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -18,14 +18,9 @@ const RelationSnippet = "[a-z][a-z0-9]*(?:[_-][a-z0-9]+)*"
 // Relation tags have the format "relation-service1.rel1#service2.rel2".
 // For peer relations, the format is "relation-service.rel"
 
-var (
-	validRelation     = regexp.MustCompile("^" + ServiceSnippet + ":" + RelationSnippet + " " + ServiceSnippet + ":" + RelationSnippet + "$")
-	validPeerRelation = regexp.MustCompile("^" + ServiceSnippet + ":" + RelationSnippet + "$")
-)
-
 // IsValidRelation returns whether key is a valid relation key.
 func IsValidRelation(key string) bool {
-	return validRelation.MatchString(key) || validPeerRelation.MatchString(key)
+	return matchRelation(key)
 }
 
 type RelationTag struct {
@@ -36,6 +31,12 @@ func (t RelationTag) String() string { return t.Kind() + "-" + t.key }
 func (t RelationTag) Kind() string   { return RelationTagKind }
 func (t RelationTag) Id() string     { return relationTagSuffixToKey(t.key) }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t RelationTag) AppendString(dst []byte) []byte {
+	return append(append(dst, RelationTagKind+KindSeparator...), t.key...)
+}
+
 // NewRelationTag returns the tag for the relation with the given key.
 func NewRelationTag(relationKey string) RelationTag {
 	if !IsValidRelation(relationKey) {
@@ -65,3 +66,42 @@ func relationTagSuffixToKey(s string) string {
 	s = strings.Replace(s, ".", ":", 2)
 	return strings.Replace(s, "#", " ", 1)
 }
+
+// CompareRelationTags returns an integer comparing two relation tags.
+// Tags are ordered by their endpoints ("service:relation"), taken in
+// sorted order so that the order in which the endpoints of a relation
+// key are written does not affect the result, with peer relations
+// sorting before other relations with the same first endpoint. The
+// result is 0 if a == b, -1 if a < b, and +1 if a > b.
+func CompareRelationTags(a, b RelationTag) int {
+	ea, eb := relationEndpoints(a), relationEndpoints(b)
+	for i := 0; i < len(ea) && i < len(eb); i++ {
+		if c := strings.Compare(ea[i], eb[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(ea) < len(eb):
+		return -1
+	case len(ea) > len(eb):
+		return 1
+	}
+	// Relations with the same endpoints written in a different order
+	// are still ordered consistently.
+	return strings.Compare(a.key, b.key)
+}
+
+// relationEndpoints returns the endpoints of the relation, sorted.
+func relationEndpoints(t RelationTag) []string {
+	endpoints := strings.Split(t.Id(), " ")
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// RelationTags attaches the methods of sort.Interface to
+// []RelationTag, ordering tags as described in CompareRelationTags.
+type RelationTags []RelationTag
+
+func (t RelationTags) Len() int           { return len(t) }
+func (t RelationTags) Less(i, j int) bool { return CompareRelationTags(t[i], t[j]) < 0 }
+func (t RelationTags) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
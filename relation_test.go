@@ -4,6 +4,8 @@
 package names_test
 
 import (
+	"sort"
+
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/names"
@@ -93,3 +95,39 @@ func (s *relationSuite) TestParseRelationTag(c *gc.C) {
 		c.Check(got, gc.Equals, t.expected)
 	}
 }
+
+func (s *relationSuite) TestCompareRelationTags(c *gc.C) {
+	for i, t := range []struct {
+		a, b   string
+		expect int
+	}{
+		{"wordpress:db mysql:server", "wordpress:db mysql:server", 0},
+		{"wordpress:db mysql:server", "mysql:server wordpress:db", 1},
+		{"mysql:server wordpress:db", "wordpress:db mysql:server", -1},
+		{"mysql:server wordpress:db", "mysql:server wordpress:web", -1},
+		{"riak:ring", "riak:ring", 0},
+		{"riak:ring", "riak:ring wordpress:db", -1},
+		{"wordpress:db mysql:server", "riak:ring", -1},
+	} {
+		c.Logf("test %d: %q %q", i, t.a, t.b)
+		a, b := names.NewRelationTag(t.a), names.NewRelationTag(t.b)
+		c.Check(names.CompareRelationTags(a, b), gc.Equals, t.expect)
+		c.Check(names.CompareRelationTags(b, a), gc.Equals, -t.expect)
+	}
+}
+
+func (s *relationSuite) TestSortRelationTags(c *gc.C) {
+	tags := names.RelationTags{
+		names.NewRelationTag("wordpress:db mysql:server"),
+		names.NewRelationTag("riak:ring"),
+		names.NewRelationTag("haproxy:reverseproxy wordpress:website"),
+		names.NewRelationTag("mysql:cluster"),
+	}
+	sort.Sort(tags)
+	c.Check(tags, gc.DeepEquals, names.RelationTags{
+		names.NewRelationTag("haproxy:reverseproxy wordpress:website"),
+		names.NewRelationTag("mysql:cluster"),
+		names.NewRelationTag("wordpress:db mysql:server"),
+		names.NewRelationTag("riak:ring"),
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const RemoteApplicationTagKind = "remoteapplication"
+
+// remoteConsumerPrefix begins the names given to proxies for
+// applications in other environments that consume an offer. The rest
+// of the name is a UUID, written without hyphens.
+const remoteConsumerPrefix = "remote-"
+
+// IsValidRemoteApplication returns whether name is a valid remote
+// application name: either an application name, for an application
+// offered by another environment, or remoteConsumerPrefix followed by
+// 32 lower-case hexadecimal digits, for an application in another
+// environment that consumes an offer.
+func IsValidRemoteApplication(name string) bool {
+	if IsValidApplication(name) {
+		return true
+	}
+	if !strings.HasPrefix(name, remoteConsumerPrefix) {
+		return false
+	}
+	hex := name[len(remoteConsumerPrefix):]
+	if len(hex) != 32 {
+		return false
+	}
+	for i := 0; i < len(hex); i++ {
+		if c := hex[i]; !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoteApplicationTag represents an application proxied from another
+// environment. It is a distinct kind from ApplicationTag, so that the
+// entities on the consuming side of a cross-environment relation are
+// not mistaken for local applications.
+type RemoteApplicationTag struct {
+	name string
+}
+
+func (t RemoteApplicationTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t RemoteApplicationTag) Kind() string   { return RemoteApplicationTagKind }
+func (t RemoteApplicationTag) Id() string     { return t.name }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t RemoteApplicationTag) AppendString(dst []byte) []byte {
+	return append(append(dst, RemoteApplicationTagKind+KindSeparator...), t.name...)
+}
+
+// NewRemoteApplicationTag returns the tag of the remote application
+// with the given name. It will panic if the given name is not valid.
+func NewRemoteApplicationTag(name string) RemoteApplicationTag {
+	if !IsValidRemoteApplication(name) {
+		panic(fmt.Sprintf("%q is not a valid remote application name", name))
+	}
+	return RemoteApplicationTag{name: name}
+}
+
+// ParseRemoteApplicationTag parses a remote application tag string.
+func ParseRemoteApplicationTag(remoteApplicationTag string) (RemoteApplicationTag, error) {
+	tag, err := ParseTag(remoteApplicationTag)
+	if err != nil {
+		return RemoteApplicationTag{}, err
+	}
+	rt, ok := tag.(RemoteApplicationTag)
+	if !ok {
+		return RemoteApplicationTag{}, invalidTagError(remoteApplicationTag, RemoteApplicationTagKind)
+	}
+	return rt, nil
+}
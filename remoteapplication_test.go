@@ -0,0 +1,90 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type remoteApplicationSuite struct{}
+
+var _ = gc.Suite(&remoteApplicationSuite{})
+
+var remoteApplicationNameTests = []struct {
+	pattern string
+	valid   bool
+}{
+	{pattern: "", valid: false},
+	{pattern: "mysql", valid: true},
+	{pattern: "hosted-mysql", valid: true},
+	{pattern: "remote-f47ac10b58cc4372a5670e02b2c3d479", valid: true},
+	{pattern: "remote-00000000000000000000000000000000", valid: true},
+	{pattern: "remote-f47ac10b-58cc-4372-a567-0e02b2c3d479", valid: false},
+	{pattern: "remote-F47AC10B58CC4372A5670E02B2C3D479", valid: false},
+	{pattern: "remote-f47ac10b", valid: true},
+	{pattern: "remote-0123456789", valid: false},
+	{pattern: "remote-0", valid: false},
+	{pattern: "mysql-0", valid: false},
+	{pattern: "mysql/0", valid: false},
+}
+
+func (s *remoteApplicationSuite) TestRemoteApplicationNames(c *gc.C) {
+	for i, test := range remoteApplicationNameTests {
+		c.Logf("test %d: %q", i, test.pattern)
+		c.Check(names.IsValidRemoteApplication(test.pattern), gc.Equals, test.valid)
+		if test.valid {
+			expectTag := fmt.Sprintf("%s-%s", names.RemoteApplicationTagKind, test.pattern)
+			c.Check(names.NewRemoteApplicationTag(test.pattern).String(), gc.Equals, expectTag)
+		} else {
+			expectErr := fmt.Sprintf("%q is not a valid remote application name", test.pattern)
+			testTag := func() { names.NewRemoteApplicationTag(test.pattern) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+		}
+	}
+}
+
+var parseRemoteApplicationTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "remoteapplication-mysql",
+	expected: names.NewRemoteApplicationTag("mysql"),
+}, {
+	tag:      "remoteapplication-remote-f47ac10b58cc4372a5670e02b2c3d479",
+	expected: names.NewRemoteApplicationTag("remote-f47ac10b58cc4372a5670e02b2c3d479"),
+}, {
+	tag: "remoteapplication-mysql-0",
+	err: names.InvalidTagError("remoteapplication-mysql-0", names.RemoteApplicationTagKind),
+}, {
+	tag: "application-mysql",
+	err: names.InvalidTagError("application-mysql", names.RemoteApplicationTagKind),
+}}
+
+func (s *remoteApplicationSuite) TestParseRemoteApplicationTag(c *gc.C) {
+	for i, t := range parseRemoteApplicationTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseRemoteApplicationTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+func (s *remoteApplicationSuite) TestDistinctFromApplication(c *gc.C) {
+	_, err := names.ParseApplicationTag("remoteapplication-mysql")
+	c.Check(err, gc.ErrorMatches, `"remoteapplication-mysql" is not a valid application tag`)
+	c.Check(names.NewRemoteApplicationTag("mysql"), gc.Not(gc.Equals), names.Tag(names.NewApplicationTag("mysql")))
+}
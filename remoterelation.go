@@ -0,0 +1,59 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const RemoteRelationTagKind = "remoterelation"
+
+// IsValidRemoteRelation returns whether key is a valid remote relation
+// key. Remote relation keys follow the same rules as relation keys.
+func IsValidRemoteRelation(key string) bool {
+	return IsValidRelation(key)
+}
+
+// RemoteRelationTag represents a relation that spans environments. Its
+// id is a relation key, written in the tag as a relation tag's is, but
+// it is a distinct kind from RelationTag.
+type RemoteRelationTag struct {
+	key string
+}
+
+func (t RemoteRelationTag) String() string { return t.Kind() + "-" + t.key }
+func (t RemoteRelationTag) Kind() string   { return RemoteRelationTagKind }
+func (t RemoteRelationTag) Id() string     { return relationTagSuffixToKey(t.key) }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t RemoteRelationTag) AppendString(dst []byte) []byte {
+	return append(append(dst, RemoteRelationTagKind+KindSeparator...), t.key...)
+}
+
+// NewRemoteRelationTag returns the tag for the remote relation with the
+// given key.
+func NewRemoteRelationTag(relationKey string) RemoteRelationTag {
+	if !IsValidRemoteRelation(relationKey) {
+		panic(fmt.Sprintf("%q is not a valid remote relation key", relationKey))
+	}
+	// Replace both ":" with "." and the " " with "#".
+	relationKey = strings.Replace(relationKey, ":", ".", 2)
+	relationKey = strings.Replace(relationKey, " ", "#", 1)
+	return RemoteRelationTag{key: relationKey}
+}
+
+// ParseRemoteRelationTag parses a remote relation tag string.
+func ParseRemoteRelationTag(remoteRelationTag string) (RemoteRelationTag, error) {
+	tag, err := ParseTag(remoteRelationTag)
+	if err != nil {
+		return RemoteRelationTag{}, err
+	}
+	rt, ok := tag.(RemoteRelationTag)
+	if !ok {
+		return RemoteRelationTag{}, invalidTagError(remoteRelationTag, RemoteRelationTagKind)
+	}
+	return rt, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type remoteRelationSuite struct{}
+
+var _ = gc.Suite(&remoteRelationSuite{})
+
+func (s *remoteRelationSuite) TestRemoteRelationTag(c *gc.C) {
+	tag := names.NewRemoteRelationTag("wordpress:db mysql:server")
+	c.Check(tag.Id(), gc.Equals, "wordpress:db mysql:server")
+	c.Check(tag.String(), gc.Equals, "remoterelation-wordpress.db#mysql.server")
+	c.Check(tag.Kind(), gc.Equals, names.RemoteRelationTagKind)
+
+	peer := names.NewRemoteRelationTag("riak:ring")
+	c.Check(peer.String(), gc.Equals, "remoterelation-riak.ring")
+
+	c.Check(func() { names.NewRemoteRelationTag("wordpress") }, gc.PanicMatches, `"wordpress" is not a valid remote relation key`)
+}
+
+var parseRemoteRelationTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "remoterelation-wordpress.db#mysql.server",
+	expected: names.NewRemoteRelationTag("wordpress:db mysql:server"),
+}, {
+	tag:      "remoterelation-riak.ring",
+	expected: names.NewRemoteRelationTag("riak:ring"),
+}, {
+	tag: "remoterelation-riak",
+	err: names.InvalidTagError("remoterelation-riak", names.RemoteRelationTagKind),
+}, {
+	tag: "relation-wordpress.db#mysql.server",
+	err: names.InvalidTagError("relation-wordpress.db#mysql.server", names.RemoteRelationTagKind),
+}}
+
+func (s *remoteRelationSuite) TestParseRemoteRelationTag(c *gc.C) {
+	for i, t := range parseRemoteRelationTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseRemoteRelationTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
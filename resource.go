@@ -0,0 +1,94 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ResourceTagKind = "resource"
+
+// ResourceNameSnippet matches the name of a resource, as declared by a
+// charm. Resource names follow the same rules as relation names.
+const ResourceNameSnippet = "(?:" + RelationSnippet + ")"
+
+// IsValidResourceName returns whether name is a valid resource name.
+func IsValidResourceName(name string) bool {
+	return matchResourceName(name)
+}
+
+// IsValidResource returns whether id is a valid resource id: the name
+// of an application and the name of one of its resources, separated
+// by a slash, such as "wordpress/logo".
+func IsValidResource(id string) bool {
+	_, _, ok := splitResourceId(id)
+	return ok
+}
+
+// splitResourceId splits a resource id into the names of the
+// application and the resource, validating both.
+func splitResourceId(id string) (application, name string, ok bool) {
+	application, name, ok = strings.Cut(id, "/")
+	if !ok || !IsValidApplication(application) || !IsValidResourceName(name) {
+		return "", "", false
+	}
+	return application, name, true
+}
+
+// ResourceTag represents a resource of an application.
+type ResourceTag struct {
+	application ApplicationTag
+	name        string
+}
+
+func (t ResourceTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t ResourceTag) Kind() string   { return ResourceTagKind }
+func (t ResourceTag) Id() string {
+	if t == (ResourceTag{}) {
+		return ""
+	}
+	return t.application.name + "/" + t.name
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ResourceTag) AppendString(dst []byte) []byte {
+	dst = append(dst, ResourceTagKind+KindSeparator...)
+	if t == (ResourceTag{}) {
+		return dst
+	}
+	return append(append(append(dst, t.application.name...), '/'), t.name...)
+}
+
+// Application returns the tag of the application the resource belongs
+// to.
+func (t ResourceTag) Application() ApplicationTag { return t.application }
+
+// Name returns the name of the resource.
+func (t ResourceTag) Name() string { return t.name }
+
+// NewResourceTag returns the tag of the resource with the given id,
+// such as "wordpress/logo". It will panic if the given id is not
+// valid.
+func NewResourceTag(id string) ResourceTag {
+	application, name, ok := splitResourceId(id)
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid resource id", id))
+	}
+	return ResourceTag{application: ApplicationTag{name: application}, name: name}
+}
+
+// ParseResourceTag parses a resource tag string.
+func ParseResourceTag(resourceTag string) (ResourceTag, error) {
+	tag, err := ParseTag(resourceTag)
+	if err != nil {
+		return ResourceTag{}, err
+	}
+	rt, ok := tag.(ResourceTag)
+	if !ok {
+		return ResourceTag{}, invalidTagError(resourceTag, ResourceTagKind)
+	}
+	return rt, nil
+}
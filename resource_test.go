@@ -0,0 +1,95 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type resourceSuite struct{}
+
+var _ = gc.Suite(&resourceSuite{})
+
+var resourceIdTests = []struct {
+	id          string
+	valid       bool
+	application string
+	name        string
+}{
+	{id: "wordpress/logo", valid: true, application: "wordpress", name: "logo"},
+	{id: "hosted-mysql/db_dump-2", valid: true, application: "hosted-mysql", name: "db_dump-2"},
+	{id: ""},
+	{id: "wordpress"},
+	{id: "wordpress/"},
+	{id: "/logo"},
+	{id: "wordpress-0/logo"},
+	{id: "wordpress/Logo"},
+	{id: "wordpress/2logo"},
+	{id: "wordpress/logo/1"},
+	{id: "wordpress/logo_"},
+}
+
+func (s *resourceSuite) TestResourceTag(c *gc.C) {
+	for i, test := range resourceIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidResource(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid resource id", test.id)
+			testTag := func() { names.NewResourceTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewResourceTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, "resource-"+test.id)
+		c.Check(tag.Application(), gc.Equals, names.NewApplicationTag(test.application))
+		c.Check(tag.Name(), gc.Equals, test.name)
+		c.Check(names.IsValidResourceName(test.name), gc.Equals, true)
+	}
+}
+
+func (s *resourceSuite) TestZeroValue(c *gc.C) {
+	var tag names.ResourceTag
+	c.Check(tag.Id(), gc.Equals, "")
+	c.Check(tag.String(), gc.Equals, "resource-")
+}
+
+var parseResourceTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "resource-wordpress/logo",
+	expected: names.NewResourceTag("wordpress/logo"),
+}, {
+	tag: "resource-wordpress-logo",
+	err: names.InvalidTagError("resource-wordpress-logo", names.ResourceTagKind),
+}, {
+	tag: "resource",
+	err: names.InvalidTagError("resource", ""),
+}, {
+	tag: "application-wordpress",
+	err: names.InvalidTagError("application-wordpress", names.ResourceTagKind),
+}}
+
+func (s *resourceSuite) TestParseResourceTag(c *gc.C) {
+	for i, t := range parseResourceTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseResourceTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const SecretTagKind = "secret"
+
+// SecretScheme is the scheme of secret URIs.
+const SecretScheme = "secret"
+
+// secretIdLength is the length of a secret id: a globally unique id
+// of 12 bytes written as 20 base32hex digits in lower case.
+const secretIdLength = 20
+
+// IsValidSecret returns whether id is a valid secret id: 20 lower-case
+// base32hex digits (0-9 and a-v), such as "9m4e2mr0ui3e8a215n4g".
+func IsValidSecret(id string) bool {
+	if len(id) != secretIdLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if c := id[i]; !('0' <= c && c <= '9' || 'a' <= c && c <= 'v') {
+			return false
+		}
+	}
+	return true
+}
+
+// SecretTag represents a secret.
+type SecretTag struct {
+	id string
+}
+
+func (t SecretTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t SecretTag) Kind() string   { return SecretTagKind }
+func (t SecretTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t SecretTag) AppendString(dst []byte) []byte {
+	return append(append(dst, SecretTagKind+KindSeparator...), t.id...)
+}
+
+// URI returns the URI of the secret, with no source environment or
+// revision.
+func (t SecretTag) URI() SecretURI {
+	return SecretURI{id: t.id}
+}
+
+// NewSecretTag returns the tag of the secret with the given id. It will
+// panic if the given id is not valid.
+func NewSecretTag(id string) SecretTag {
+	if !IsValidSecret(id) {
+		panic(fmt.Sprintf("%q is not a valid secret id", id))
+	}
+	return SecretTag{id: id}
+}
+
+// ParseSecretTag parses a secret tag string.
+func ParseSecretTag(secretTag string) (SecretTag, error) {
+	tag, err := ParseTag(secretTag)
+	if err != nil {
+		return SecretTag{}, err
+	}
+	st, ok := tag.(SecretTag)
+	if !ok {
+		return SecretTag{}, invalidTagError(secretTag, SecretTagKind)
+	}
+	return st, nil
+}
+
+// SecretURI refers to a secret, optionally in a given source
+// environment and at a given revision. Its string form is
+//
+//	secret:<id>[/<revision>]
+//
+// or, when the source environment is known,
+//
+//	secret://<environment-uuid>/<id>[/<revision>]
+type SecretURI struct {
+	sourceUUID string
+	id         string
+	revision   int
+}
+
+// NewSecretURI returns the URI of the secret with the given tag in the
+// environment with the given UUID, which may be empty if the source
+// environment is not known. It returns an error if either is invalid.
+func NewSecretURI(secret SecretTag, sourceUUID string) (SecretURI, error) {
+	if !IsValidSecret(secret.Id()) {
+		return SecretURI{}, fmt.Errorf("%q is not a valid secret id", secret.Id())
+	}
+	if _, ok := parseUUID(sourceUUID); sourceUUID != "" && !ok {
+		return SecretURI{}, fmt.Errorf("%q is not a valid source environment UUID", sourceUUID)
+	}
+	return SecretURI{sourceUUID: sourceUUID, id: secret.Id()}, nil
+}
+
+// ParseSecretURI parses the string form of a secret URI.
+func ParseSecretURI(s string) (SecretURI, error) {
+	rest, ok := strings.CutPrefix(s, SecretScheme+":")
+	if !ok {
+		return SecretURI{}, fmt.Errorf("%q is not a valid secret URI", s)
+	}
+	var uri SecretURI
+	if rest, ok = strings.CutPrefix(rest, "//"); ok {
+		i := strings.Index(rest, "/")
+		if i < 0 {
+			return SecretURI{}, fmt.Errorf("%q is not a valid secret URI", s)
+		}
+		if _, ok := parseUUID(rest[:i]); !ok {
+			return SecretURI{}, fmt.Errorf("%q is not a valid secret URI: invalid source environment UUID %q", s, rest[:i])
+		}
+		uri.sourceUUID, rest = rest[:i], rest[i+1:]
+	}
+	id, revision, hasRevision := strings.Cut(rest, "/")
+	if !IsValidSecret(id) {
+		return SecretURI{}, fmt.Errorf("%q is not a valid secret URI: invalid secret id %q", s, id)
+	}
+	uri.id = id
+	if hasRevision {
+		n, err := strconv.Atoi(revision)
+		if err != nil || n < 1 || !matchNumber(revision) {
+			return SecretURI{}, fmt.Errorf("%q is not a valid secret URI: invalid revision %q", s, revision)
+		}
+		uri.revision = n
+	}
+	return uri, nil
+}
+
+// Tag returns the tag of the secret.
+func (u SecretURI) Tag() SecretTag { return SecretTag{id: u.id} }
+
+// SourceUUID returns the UUID of the environment holding the secret,
+// or the empty string if it is not known.
+func (u SecretURI) SourceUUID() string { return u.sourceUUID }
+
+// Revision returns the revision of the secret the URI refers to, and
+// whether it refers to a particular revision at all.
+func (u SecretURI) Revision() (int, bool) {
+	return u.revision, u.revision > 0
+}
+
+// WithRevision returns the URI of the given revision of the secret. A
+// revision of 0 refers to no particular revision. It panics if n is
+// negative.
+func (u SecretURI) WithRevision(n int) SecretURI {
+	if n < 0 {
+		panic(fmt.Sprintf("invalid secret revision %d", n))
+	}
+	u.revision = n
+	return u
+}
+
+// String returns the string form of the URI.
+func (u SecretURI) String() string {
+	var b strings.Builder
+	b.WriteString(SecretScheme + ":")
+	if u.sourceUUID != "" {
+		b.WriteString("//" + u.sourceUUID + "/")
+	}
+	b.WriteString(u.id)
+	if u.revision > 0 {
+		b.WriteString("/" + strconv.Itoa(u.revision))
+	}
+	return b.String()
+}
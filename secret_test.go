@@ -0,0 +1,166 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type secretSuite struct{}
+
+var _ = gc.Suite(&secretSuite{})
+
+const (
+	secretId    = "9m4e2mr0ui3e8a215n4g"
+	secretModel = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+)
+
+func (s *secretSuite) TestIsValidSecret(c *gc.C) {
+	c.Check(names.IsValidSecret(secretId), gc.Equals, true)
+	c.Check(names.IsValidSecret("00000000000000000000"), gc.Equals, true)
+	c.Check(names.IsValidSecret(""), gc.Equals, false)
+	c.Check(names.IsValidSecret("9m4e2mr0ui3e8a215n4"), gc.Equals, false)
+	c.Check(names.IsValidSecret("9m4e2mr0ui3e8a215n4gg"), gc.Equals, false)
+	c.Check(names.IsValidSecret("9m4e2mr0ui3e8a215n4w"), gc.Equals, false)
+	c.Check(names.IsValidSecret("9M4E2MR0UI3E8A215N4G"), gc.Equals, false)
+	c.Check(func() { names.NewSecretTag("foo") }, gc.PanicMatches, `"foo" is not a valid secret id`)
+}
+
+var parseSecretTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "secret-" + secretId,
+	expected: names.NewSecretTag(secretId),
+}, {
+	tag: "secret-foo",
+	err: names.InvalidTagError("secret-foo", names.SecretTagKind),
+}, {
+	tag: "secret",
+	err: names.InvalidTagError("secret", ""),
+}, {
+	tag: "user-" + secretId,
+	err: names.InvalidTagError("user-"+secretId, names.SecretTagKind),
+}}
+
+func (s *secretSuite) TestParseSecretTag(c *gc.C) {
+	for i, t := range parseSecretTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseSecretTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+var parseSecretURITests = []struct {
+	uri         string
+	sourceUUID  string
+	revision    int
+	hasRevision bool
+	err         string
+}{{
+	uri: "secret:" + secretId,
+}, {
+	uri:         "secret:" + secretId + "/3",
+	revision:    3,
+	hasRevision: true,
+}, {
+	uri:        "secret://" + secretModel + "/" + secretId,
+	sourceUUID: secretModel,
+}, {
+	uri:         "secret://" + secretModel + "/" + secretId + "/12",
+	sourceUUID:  secretModel,
+	revision:    12,
+	hasRevision: true,
+}, {
+	uri: "",
+	err: `"" is not a valid secret URI`,
+}, {
+	uri: secretId,
+	err: `"9m4e2mr0ui3e8a215n4g" is not a valid secret URI`,
+}, {
+	uri: "secrets:" + secretId,
+	err: `"secrets:9m4e2mr0ui3e8a215n4g" is not a valid secret URI`,
+}, {
+	uri: "secret:foo",
+	err: `"secret:foo" is not a valid secret URI: invalid secret id "foo"`,
+}, {
+	uri: "secret:" + secretId + "/0",
+	err: `".*" is not a valid secret URI: invalid revision "0"`,
+}, {
+	uri: "secret:" + secretId + "/03",
+	err: `".*" is not a valid secret URI: invalid revision "03"`,
+}, {
+	uri: "secret:" + secretId + "/",
+	err: `".*" is not a valid secret URI: invalid revision ""`,
+}, {
+	uri: "secret:" + secretId + "/3/4",
+	err: `".*" is not a valid secret URI: invalid revision "3/4"`,
+}, {
+	uri: "secret://" + secretId,
+	err: `".*" is not a valid secret URI`,
+}, {
+	uri: "secret://f47ac10b/" + secretId,
+	err: `".*" is not a valid secret URI: invalid source environment UUID "f47ac10b"`,
+}}
+
+func (s *secretSuite) TestParseSecretURI(c *gc.C) {
+	for i, test := range parseSecretURITests {
+		c.Logf("test %d: %q", i, test.uri)
+		uri, err := names.ParseSecretURI(test.uri)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+		c.Check(uri.Tag(), gc.Equals, names.NewSecretTag(secretId))
+		c.Check(uri.SourceUUID(), gc.Equals, test.sourceUUID)
+		revision, ok := uri.Revision()
+		c.Check(revision, gc.Equals, test.revision)
+		c.Check(ok, gc.Equals, test.hasRevision)
+		c.Check(uri.String(), gc.Equals, test.uri)
+	}
+}
+
+func (s *secretSuite) TestNewSecretURI(c *gc.C) {
+	tag := names.NewSecretTag(secretId)
+	uri, err := names.NewSecretURI(tag, "")
+	c.Assert(err, gc.IsNil)
+	c.Check(uri, gc.Equals, tag.URI())
+	c.Check(uri.String(), gc.Equals, "secret:"+secretId)
+
+	uri, err = names.NewSecretURI(tag, secretModel)
+	c.Assert(err, gc.IsNil)
+	c.Check(uri.String(), gc.Equals, "secret://"+secretModel+"/"+secretId)
+
+	_, err = names.NewSecretURI(names.SecretTag{}, "")
+	c.Check(err, gc.ErrorMatches, `"" is not a valid secret id`)
+	_, err = names.NewSecretURI(tag, "foo")
+	c.Check(err, gc.ErrorMatches, `"foo" is not a valid source environment UUID`)
+}
+
+func (s *secretSuite) TestWithRevision(c *gc.C) {
+	uri := names.NewSecretTag(secretId).URI()
+	rev := uri.WithRevision(5)
+	c.Check(rev.String(), gc.Equals, "secret:"+secretId+"/5")
+	n, ok := rev.Revision()
+	c.Check(n, gc.Equals, 5)
+	c.Check(ok, gc.Equals, true)
+
+	// The original is unchanged, and revision 0 removes the revision.
+	_, ok = uri.Revision()
+	c.Check(ok, gc.Equals, false)
+	c.Check(rev.WithRevision(0), gc.Equals, uri)
+	c.Check(func() { uri.WithRevision(-1) }, gc.PanicMatches, `invalid secret revision -1`)
+}
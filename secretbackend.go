@@ -0,0 +1,60 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const SecretBackendTagKind = "secretbackend"
+
+// IsValidSecretBackend returns whether id is a valid secret backend id:
+// either the UUID of the backend or its name. Backend names follow the
+// same rules as cloud credential names: letters, digits, dots, hyphens
+// and underscores, starting with a letter or digit, such as "vault" or
+// "internal".
+func IsValidSecretBackend(id string) bool {
+	if _, ok := parseUUID(id); ok {
+		return true
+	}
+	return matchCloudCredentialName(id)
+}
+
+// SecretBackendTag represents a secret backend, which stores the
+// content of secrets.
+type SecretBackendTag struct {
+	id string
+}
+
+func (t SecretBackendTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t SecretBackendTag) Kind() string   { return SecretBackendTagKind }
+func (t SecretBackendTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t SecretBackendTag) AppendString(dst []byte) []byte {
+	return append(append(dst, SecretBackendTagKind+KindSeparator...), t.id...)
+}
+
+// NewSecretBackendTag returns the tag of the secret backend with the
+// given UUID or name. It will panic if the given id is not valid.
+func NewSecretBackendTag(id string) SecretBackendTag {
+	if !IsValidSecretBackend(id) {
+		panic(fmt.Sprintf("%q is not a valid secret backend id", id))
+	}
+	return SecretBackendTag{id: id}
+}
+
+// ParseSecretBackendTag parses a secret backend tag string.
+func ParseSecretBackendTag(secretBackendTag string) (SecretBackendTag, error) {
+	tag, err := ParseTag(secretBackendTag)
+	if err != nil {
+		return SecretBackendTag{}, err
+	}
+	st, ok := tag.(SecretBackendTag)
+	if !ok {
+		return SecretBackendTag{}, invalidTagError(secretBackendTag, SecretBackendTagKind)
+	}
+	return st, nil
+}
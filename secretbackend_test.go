@@ -0,0 +1,82 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type secretBackendSuite struct{}
+
+var _ = gc.Suite(&secretBackendSuite{})
+
+var secretBackendIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "f47ac10b-58cc-4372-a567-0e02b2c3d479", valid: true},
+	{id: "vault", valid: true},
+	{id: "myvault-2", valid: true},
+	{id: "Vault_prod.1", valid: true},
+	{id: ""},
+	{id: "-vault"},
+	{id: ".vault"},
+	{id: "vault/1"},
+	{id: "vault backend"},
+}
+
+func (s *secretBackendSuite) TestSecretBackendTag(c *gc.C) {
+	for i, test := range secretBackendIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidSecretBackend(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid secret backend id", test.id)
+			testTag := func() { names.NewSecretBackendTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewSecretBackendTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, "secretbackend-"+test.id)
+	}
+}
+
+var parseSecretBackendTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "secretbackend-vault",
+	expected: names.NewSecretBackendTag("vault"),
+}, {
+	tag:      "secretbackend-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewSecretBackendTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "secretbackend-vault/1",
+	err: names.InvalidTagError("secretbackend-vault/1", names.SecretBackendTagKind),
+}, {
+	tag: "secret-9m4e2mr0ui3e8a215n4g",
+	err: names.InvalidTagError("secret-9m4e2mr0ui3e8a215n4g", names.SecretBackendTagKind),
+}}
+
+func (s *secretBackendSuite) TestParseSecretBackendTag(c *gc.C) {
+	for i, t := range parseSecretBackendTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseSecretBackendTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
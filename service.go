@@ -3,10 +3,6 @@
 
 package names
 
-import (
-	"regexp"
-)
-
 const ServiceTagKind = "service"
 
 const (
@@ -14,11 +10,9 @@ const (
 	NumberSnippet  = "(?:0|[1-9][0-9]*)"
 )
 
-var validService = regexp.MustCompile("^" + ServiceSnippet + "$")
-
 // IsValidService returns whether name is a valid service name.
 func IsValidService(name string) bool {
-	return validService.MatchString(name)
+	return matchService(name)
 }
 
 type ServiceTag struct {
@@ -29,6 +23,12 @@ func (t ServiceTag) String() string { return t.Kind() + "-" + t.Id() }
 func (t ServiceTag) Kind() string   { return ServiceTagKind }
 func (t ServiceTag) Id() string     { return t.Name }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t ServiceTag) AppendString(dst []byte) []byte {
+	return append(append(dst, ServiceTagKind+KindSeparator...), t.Name...)
+}
+
 // NewServiceTag returns the tag for the service with the given name.
 func NewServiceTag(serviceName string) ServiceTag {
 	return ServiceTag{Name: serviceName}
@@ -0,0 +1,38 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import "hash/fnv"
+
+// SetHash returns a hash of the set of the given tags. It does not
+// depend on the order of the tags or on any duplicates among them, and
+// is stable across processes, so it can be stored and compared later
+// to detect whether the membership of a set has changed.
+func SetHash(tags []Tag) uint64 {
+	seen := make(map[string]bool, len(tags))
+	var sum uint64
+	for _, tag := range tags {
+		s := tag.String()
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		h := fnv.New64a()
+		h.Write([]byte(s))
+		// Mix each element hash before adding, so that related tag
+		// strings do not cancel each other out in the sum.
+		sum += mix64(h.Sum64())
+	}
+	return mix64(sum ^ uint64(len(seen)))
+}
+
+// mix64 is the splitmix64 finalizer.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,35 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type setHashSuite struct{}
+
+var _ = gc.Suite(&setHashSuite{})
+
+func (s *setHashSuite) TestSetHash(c *gc.C) {
+	mysql0 := names.NewUnitTag("mysql/0")
+	mysql1 := names.NewUnitTag("mysql/1")
+	machine0 := names.NewMachineTag("0")
+
+	hash := names.SetHash([]names.Tag{mysql0, mysql1, machine0})
+	c.Check(names.SetHash([]names.Tag{machine0, mysql0, mysql1}), gc.Equals, hash)
+	c.Check(names.SetHash([]names.Tag{mysql1, machine0, mysql0, mysql1}), gc.Equals, hash)
+	c.Check(names.SetHash([]names.Tag{mysql0, mysql1}), gc.Not(gc.Equals), hash)
+	c.Check(names.SetHash([]names.Tag{mysql0, mysql1, names.NewMachineTag("1")}), gc.Not(gc.Equals), hash)
+	c.Check(names.SetHash(nil), gc.Equals, names.SetHash([]names.Tag{}))
+	c.Check(names.SetHash(nil), gc.Not(gc.Equals), names.SetHash([]names.Tag{mysql0}))
+}
+
+func (s *setHashSuite) TestSetHashStable(c *gc.C) {
+	// The hash may be stored, so it must not change between releases.
+	c.Check(names.SetHash(nil), gc.Equals, uint64(0))
+	hash := names.SetHash([]names.Tag{names.NewUnitTag("mysql/0"), names.NewMachineTag("0")})
+	c.Check(hash, gc.Equals, uint64(0x146052d1d4d75339))
+}
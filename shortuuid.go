@@ -0,0 +1,154 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MinShortUUIDLength is the minimum length of the abbreviated UUIDs
+// returned by ShortUUID.
+const MinShortUUIDLength = 8
+
+// ShortUUID returns the shortest prefix of uuid, at least
+// MinShortUUIDLength characters long, that is not also a prefix of
+// any of the other UUIDs. Like an abbreviated git hash, the result
+// identifies uuid unambiguously amongst others, and may be turned
+// back into the full UUID with ResolveShortUUID.
+func ShortUUID(uuid string, others []string) string {
+	n := MinShortUUIDLength
+	for _, other := range others {
+		if other == uuid {
+			continue
+		}
+		// Find the length of the common prefix and make sure
+		// the abbreviation extends at least one character beyond it.
+		i := 0
+		for i < len(uuid) && i < len(other) && uuid[i] == other[i] {
+			i++
+		}
+		if i+1 > n {
+			n = i + 1
+		}
+	}
+	if n >= len(uuid) {
+		return uuid
+	}
+	short := uuid[:n]
+	// Don't leave a dangling group separator.
+	return strings.TrimSuffix(short, "-")
+}
+
+// ResolveShortUUID returns the single candidate UUID that starts with
+// the given abbreviation. It returns an error if no candidate, or
+// more than one, matches.
+func ResolveShortUUID(short string, candidates []string) (string, error) {
+	match, err := resolveShortUUID(short, candidates)
+	if err != nil {
+		return "", err
+	}
+	return candidates[match], nil
+}
+
+// resolveShortUUID returns the index of the candidate that starts
+// with short.
+func resolveShortUUID(short string, candidates []string) (int, error) {
+	short = strings.ToLower(short)
+	if short == "" {
+		return -1, fmt.Errorf("empty UUID prefix")
+	}
+	match := -1
+	count := 0
+	for i, candidate := range candidates {
+		if !strings.HasPrefix(candidate, short) {
+			continue
+		}
+		if match >= 0 && candidates[match] == candidate {
+			continue
+		}
+		match = i
+		count++
+	}
+	switch count {
+	case 0:
+		return -1, fmt.Errorf("UUID prefix %q matches nothing", short)
+	case 1:
+		return match, nil
+	}
+	return -1, fmt.Errorf("UUID prefix %q is ambiguous: matches %d UUIDs", short, count)
+}
+
+// UUIDTagConstraint is satisfied by the tag types whose ids are UUIDs,
+// so that their UUIDs can be abbreviated with ShortTagUUID and resolved
+// with ResolveUUIDTag.
+type UUIDTagConstraint interface {
+	EnvironTag | ModelTag | ControllerTag | MetricBatchTag | UpgradeTag |
+		MigrationTag
+	Tag
+}
+
+// ShortTagUUID returns the abbreviated form of tag's UUID that
+// distinguishes it from the UUIDs of the other tags, as described in
+// ShortUUID.
+func ShortTagUUID[T UUIDTagConstraint](tag T, others []T) string {
+	return ShortUUID(tag.Id(), tagUUIDs(others))
+}
+
+// ResolveUUIDTag returns the single tag amongst candidates whose UUID
+// starts with short.
+func ResolveUUIDTag[T UUIDTagConstraint](short string, candidates []T) (T, error) {
+	match, err := resolveShortUUID(short, tagUUIDs(candidates))
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return candidates[match], nil
+}
+
+func tagUUIDs[T UUIDTagConstraint](tags []T) []string {
+	uuids := make([]string, len(tags))
+	for i, tag := range tags {
+		uuids[i] = tag.Id()
+	}
+	return uuids
+}
+
+// ShortEnvironUUID returns the abbreviated form of tag's environment
+// UUID that distinguishes it from the other environments, as
+// described in ShortUUID.
+func ShortEnvironUUID(tag EnvironTag, others []EnvironTag) string {
+	return ShortTagUUID(tag, others)
+}
+
+// ResolveEnvironTag returns the tag of the single environment amongst
+// candidates whose UUID starts with short.
+func ResolveEnvironTag(short string, candidates []EnvironTag) (EnvironTag, error) {
+	return ResolveUUIDTag(short, candidates)
+}
+
+// ShortModelUUID returns the abbreviated form of tag's model UUID that
+// distinguishes it from the other models, as described in ShortUUID.
+func ShortModelUUID(tag ModelTag, others []ModelTag) string {
+	return ShortTagUUID(tag, others)
+}
+
+// ResolveModelTag returns the tag of the single model amongst
+// candidates whose UUID starts with short.
+func ResolveModelTag(short string, candidates []ModelTag) (ModelTag, error) {
+	return ResolveUUIDTag(short, candidates)
+}
+
+// ShortControllerUUID returns the abbreviated form of tag's controller
+// UUID that distinguishes it from the other controllers, as described
+// in ShortUUID.
+func ShortControllerUUID(tag ControllerTag, others []ControllerTag) string {
+	return ShortTagUUID(tag, others)
+}
+
+// ResolveControllerTag returns the tag of the single controller
+// amongst candidates whose UUID starts with short.
+func ResolveControllerTag(short string, candidates []ControllerTag) (ControllerTag, error) {
+	return ResolveUUIDTag(short, candidates)
+}
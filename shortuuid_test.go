@@ -0,0 +1,107 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type shortUUIDSuite struct{}
+
+var _ = gc.Suite(&shortUUIDSuite{})
+
+var shortUUIDs = []string{
+	"f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	"f47ac10b-58cd-4372-a567-0e02b2c3d479",
+	"f47ac10c-58cc-4372-a567-0e02b2c3d479",
+	"0b5ab0d8-e6a9-4a5a-8c1b-6a2ab8d3cf44",
+}
+
+func (s *shortUUIDSuite) TestShortUUID(c *gc.C) {
+	c.Check(names.ShortUUID(shortUUIDs[0], nil), gc.Equals, "f47ac10b")
+	c.Check(names.ShortUUID(shortUUIDs[0], shortUUIDs), gc.Equals, "f47ac10b-58cc")
+	c.Check(names.ShortUUID(shortUUIDs[1], shortUUIDs), gc.Equals, "f47ac10b-58cd")
+	c.Check(names.ShortUUID(shortUUIDs[2], shortUUIDs), gc.Equals, "f47ac10c")
+	c.Check(names.ShortUUID(shortUUIDs[3], shortUUIDs), gc.Equals, "0b5ab0d8")
+	// An abbreviation never ends with a separator.
+	c.Check(names.ShortUUID(shortUUIDs[0], []string{"f47ac10b-68cc-4372-a567-0e02b2c3d479"}), gc.Equals, "f47ac10b-5")
+}
+
+func (s *shortUUIDSuite) TestResolveShortUUID(c *gc.C) {
+	for i, uuid := range shortUUIDs {
+		c.Logf("test %d: %s", i, uuid)
+		got, err := names.ResolveShortUUID(names.ShortUUID(uuid, shortUUIDs), shortUUIDs)
+		c.Check(err, gc.IsNil)
+		c.Check(got, gc.Equals, uuid)
+	}
+	got, err := names.ResolveShortUUID("0B5A", shortUUIDs)
+	c.Check(err, gc.IsNil)
+	c.Check(got, gc.Equals, shortUUIDs[3])
+
+	_, err = names.ResolveShortUUID("f47ac10b", shortUUIDs)
+	c.Check(err, gc.ErrorMatches, `UUID prefix "f47ac10b" is ambiguous: matches 2 UUIDs`)
+	_, err = names.ResolveShortUUID("abc", shortUUIDs)
+	c.Check(err, gc.ErrorMatches, `UUID prefix "abc" matches nothing`)
+	_, err = names.ResolveShortUUID("", shortUUIDs)
+	c.Check(err, gc.ErrorMatches, `empty UUID prefix`)
+}
+
+func (s *shortUUIDSuite) TestEnvironTags(c *gc.C) {
+	var tags []names.EnvironTag
+	for _, uuid := range shortUUIDs {
+		tags = append(tags, names.NewEnvironTag(uuid))
+	}
+	short := names.ShortEnvironUUID(tags[1], tags)
+	c.Check(short, gc.Equals, "f47ac10b-58cd")
+	tag, err := names.ResolveEnvironTag(short, tags)
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, tags[1])
+	_, err = names.ResolveEnvironTag("f47ac", tags)
+	c.Check(err, gc.ErrorMatches, `UUID prefix "f47ac" is ambiguous: matches 3 UUIDs`)
+}
+
+func (s *shortUUIDSuite) TestModelTags(c *gc.C) {
+	var tags []names.ModelTag
+	for _, uuid := range shortUUIDs {
+		tags = append(tags, names.NewModelTag(uuid))
+	}
+	short := names.ShortModelUUID(tags[1], tags)
+	c.Check(short, gc.Equals, "f47ac10b-58cd")
+	tag, err := names.ResolveModelTag(short, tags)
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, tags[1])
+	_, err = names.ResolveModelTag("f47ac", tags)
+	c.Check(err, gc.ErrorMatches, `UUID prefix "f47ac" is ambiguous: matches 3 UUIDs`)
+}
+
+func (s *shortUUIDSuite) TestControllerTags(c *gc.C) {
+	var tags []names.ControllerTag
+	for _, uuid := range shortUUIDs {
+		tags = append(tags, names.NewControllerTag(uuid))
+	}
+	short := names.ShortControllerUUID(tags[3], tags)
+	c.Check(names.ShortUUID(shortUUIDs[3], shortUUIDs), gc.Equals, short)
+	tag, err := names.ResolveControllerTag(short, tags)
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, tags[3])
+	_, err = names.ResolveControllerTag("abc", tags)
+	c.Check(err, gc.ErrorMatches, `UUID prefix "abc" matches nothing`)
+}
+
+func (s *shortUUIDSuite) TestGenericUUIDTags(c *gc.C) {
+	var tags []names.MigrationTag
+	for _, uuid := range shortUUIDs {
+		tags = append(tags, names.NewMigrationTag(uuid))
+	}
+	short := names.ShortTagUUID(tags[1], tags)
+	c.Check(short, gc.Equals, "f47ac10b-58cd")
+	tag, err := names.ResolveUUIDTag(short, tags)
+	c.Check(err, gc.IsNil)
+	c.Check(tag, gc.Equals, tags[1])
+	tag, err = names.ResolveUUIDTag("f47ac", tags)
+	c.Check(err, gc.ErrorMatches, `UUID prefix "f47ac" is ambiguous: matches 3 UUIDs`)
+	c.Check(tag, gc.Equals, names.MigrationTag{})
+}
@@ -0,0 +1,35 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import "fmt"
+
+// SSHTarget returns the target string that identifies the given unit
+// or machine to ssh and scp style commands, such as "mysql/0" or
+// "0/lxc/2". An agent tag is treated as the entity it runs for.
+func SSHTarget(tag Tag) (string, error) {
+	if agent, ok := tag.(AgentTag); ok {
+		tag = agent.Entity()
+	}
+	switch tag := tag.(type) {
+	case UnitTag, MachineTag:
+		return tag.Id(), nil
+	case nil:
+		return "", fmt.Errorf("no tag to use as an ssh target")
+	}
+	return "", fmt.Errorf("%q cannot be used as an ssh target", tag)
+}
+
+// TagFromSSHTarget returns the tag of the unit or machine identified by
+// the given ssh target string. Machine ids take precedence, and
+// anything else must be a valid unit name.
+func TagFromSSHTarget(target string) (Tag, error) {
+	switch {
+	case IsValidMachine(target):
+		return NewMachineTag(target), nil
+	case IsValidUnit(target):
+		return NewUnitTag(target), nil
+	}
+	return nil, fmt.Errorf("%q is not a valid ssh target", target)
+}
@@ -0,0 +1,54 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type sshSuite struct{}
+
+var _ = gc.Suite(&sshSuite{})
+
+var sshTargetTests = []struct {
+	target string
+	tag    names.Tag
+}{
+	{"0", names.NewMachineTag("0")},
+	{"0/lxc/2", names.NewMachineTag("0/lxc/2")},
+	{"mysql/0", names.NewUnitTag("mysql/0")},
+	{"wordpress-site/12", names.NewUnitTag("wordpress-site/12")},
+}
+
+func (s *sshSuite) TestSSHTarget(c *gc.C) {
+	for i, test := range sshTargetTests {
+		c.Logf("test %d: %q", i, test.target)
+		target, err := names.SSHTarget(test.tag)
+		c.Assert(err, gc.IsNil)
+		c.Check(target, gc.Equals, test.target)
+		tag, err := names.TagFromSSHTarget(test.target)
+		c.Assert(err, gc.IsNil)
+		c.Check(tag, gc.Equals, test.tag)
+	}
+
+	agent, err := names.NewAgentTag(names.NewUnitTag("mysql/1"))
+	c.Assert(err, gc.IsNil)
+	target, err := names.SSHTarget(agent)
+	c.Assert(err, gc.IsNil)
+	c.Check(target, gc.Equals, "mysql/1")
+
+	_, err = names.SSHTarget(names.NewServiceTag("mysql"))
+	c.Check(err, gc.ErrorMatches, `"service-mysql" cannot be used as an ssh target`)
+	_, err = names.SSHTarget(nil)
+	c.Check(err, gc.ErrorMatches, `no tag to use as an ssh target`)
+}
+
+func (s *sshSuite) TestTagFromSSHTargetInvalid(c *gc.C) {
+	for _, target := range []string{"", "mysql", "mysql/01", "0/lxc", "unit-mysql-0", "ubuntu@mysql/0"} {
+		_, err := names.TagFromSSHTarget(target)
+		c.Check(err, gc.ErrorMatches, `".*" is not a valid ssh target`)
+	}
+}
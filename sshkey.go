@@ -0,0 +1,99 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const SSHKeyTagKind = "sshkey"
+
+// Prefixes of SSH key fingerprints, as printed by ssh-keygen -l.
+const (
+	md5FingerprintPrefix    = "MD5:"
+	sha256FingerprintPrefix = "SHA256:"
+)
+
+// IsValidSSHKey returns whether fingerprint is a valid SSH public key
+// fingerprint. Both the MD5 form of 16 colon-separated hexadecimal
+// bytes, optionally prefixed with "MD5:", and the SHA256 form of
+// "SHA256:" followed by base64, with or without padding, are valid.
+func IsValidSSHKey(fingerprint string) bool {
+	_, ok := canonicalSSHKeyFingerprint(fingerprint)
+	return ok
+}
+
+// canonicalSSHKeyFingerprint returns the canonical form of
+// fingerprint: MD5 fingerprints in lower case without a prefix, such as
+// "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48", and SHA256
+// fingerprints with unpadded base64.
+func canonicalSSHKeyFingerprint(fingerprint string) (string, bool) {
+	if digest, ok := strings.CutPrefix(fingerprint, sha256FingerprintPrefix); ok {
+		sum, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(digest, "="))
+		if err != nil || len(sum) != 32 {
+			return "", false
+		}
+		return sha256FingerprintPrefix + base64.RawStdEncoding.EncodeToString(sum), true
+	}
+	digest := strings.TrimPrefix(fingerprint, md5FingerprintPrefix)
+	parts := strings.Split(digest, ":")
+	if len(parts) != 16 {
+		return "", false
+	}
+	for _, part := range parts {
+		if len(part) != 2 {
+			return "", false
+		}
+		if _, err := hex.DecodeString(part); err != nil {
+			return "", false
+		}
+	}
+	return strings.ToLower(digest), true
+}
+
+// SSHKeyTag represents an SSH public key, identified by its
+// fingerprint.
+type SSHKeyTag struct {
+	fingerprint string
+}
+
+func (t SSHKeyTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t SSHKeyTag) Kind() string   { return SSHKeyTagKind }
+func (t SSHKeyTag) Id() string     { return t.fingerprint }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t SSHKeyTag) AppendString(dst []byte) []byte {
+	return append(append(dst, SSHKeyTagKind+KindSeparator...), t.fingerprint...)
+}
+
+// NewSSHKeyTag returns the tag of the SSH key with the given
+// fingerprint. The tag's id is the canonical form of the fingerprint,
+// so that, for example, "MD5:16:27:AC:..." yields the tag
+// "sshkey-16:27:ac:...". It will panic if the given fingerprint is not
+// valid.
+func NewSSHKeyTag(fingerprint string) SSHKeyTag {
+	canonical, ok := canonicalSSHKeyFingerprint(fingerprint)
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid SSH key fingerprint", fingerprint))
+	}
+	return SSHKeyTag{fingerprint: canonical}
+}
+
+// ParseSSHKeyTag parses an SSH key tag string. Only tags holding the
+// canonical form of a fingerprint are valid.
+func ParseSSHKeyTag(sshKeyTag string) (SSHKeyTag, error) {
+	tag, err := ParseTag(sshKeyTag)
+	if err != nil {
+		return SSHKeyTag{}, err
+	}
+	kt, ok := tag.(SSHKeyTag)
+	if !ok {
+		return SSHKeyTag{}, invalidTagError(sshKeyTag, SSHKeyTagKind)
+	}
+	return kt, nil
+}
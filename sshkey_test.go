@@ -0,0 +1,101 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type sshKeySuite struct{}
+
+var _ = gc.Suite(&sshKeySuite{})
+
+const (
+	md5Fingerprint    = "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48"
+	sha256Fingerprint = "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8"
+)
+
+var sshKeyFingerprintTests = []struct {
+	fingerprint string
+	canonical   string
+}{
+	{fingerprint: md5Fingerprint, canonical: md5Fingerprint},
+	{fingerprint: "MD5:" + md5Fingerprint, canonical: md5Fingerprint},
+	{fingerprint: "16:27:AC:A5:76:28:2D:36:63:1B:56:4D:EB:DF:A6:48", canonical: md5Fingerprint},
+	{fingerprint: sha256Fingerprint, canonical: sha256Fingerprint},
+	{fingerprint: sha256Fingerprint + "=", canonical: sha256Fingerprint},
+	{fingerprint: ""},
+	{fingerprint: "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6"},
+	{fingerprint: "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48:00"},
+	{fingerprint: "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:4g"},
+	{fingerprint: "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:4"},
+	{fingerprint: "md5:" + md5Fingerprint},
+	{fingerprint: "SHA256:"},
+	{fingerprint: "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY"},
+	{fingerprint: "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8A"},
+	{fingerprint: "SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5S-8"},
+	{fingerprint: "sha256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8"},
+}
+
+func (s *sshKeySuite) TestSSHKeyTag(c *gc.C) {
+	for i, test := range sshKeyFingerprintTests {
+		c.Logf("test %d: %q", i, test.fingerprint)
+		valid := test.canonical != ""
+		c.Check(names.IsValidSSHKey(test.fingerprint), gc.Equals, valid)
+		if !valid {
+			expectErr := fmt.Sprintf("%q is not a valid SSH key fingerprint", test.fingerprint)
+			testTag := func() { names.NewSSHKeyTag(test.fingerprint) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewSSHKeyTag(test.fingerprint)
+		c.Check(tag.Id(), gc.Equals, test.canonical)
+		c.Check(tag.String(), gc.Equals, "sshkey-"+test.canonical)
+	}
+}
+
+var parseSSHKeyTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "sshkey-" + md5Fingerprint,
+	expected: names.NewSSHKeyTag(md5Fingerprint),
+}, {
+	tag:      "sshkey-" + sha256Fingerprint,
+	expected: names.NewSSHKeyTag(sha256Fingerprint),
+}, {
+	tag: "sshkey-MD5:" + md5Fingerprint,
+	err: names.InvalidTagError("sshkey-MD5:"+md5Fingerprint, names.SSHKeyTagKind),
+}, {
+	tag: "sshkey-" + sha256Fingerprint + "=",
+	err: names.InvalidTagError("sshkey-"+sha256Fingerprint+"=", names.SSHKeyTagKind),
+}, {
+	tag: "sshkey-16:27",
+	err: names.InvalidTagError("sshkey-16:27", names.SSHKeyTagKind),
+}, {
+	tag: "user-bob",
+	err: names.InvalidTagError("user-bob", names.SSHKeyTagKind),
+}}
+
+func (s *sshKeySuite) TestParseSSHKeyTag(c *gc.C) {
+	for i, t := range parseSSHKeyTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseSSHKeyTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
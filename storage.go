@@ -0,0 +1,115 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const StorageTagKind = "storage"
+
+// StorageNameSnippet matches the name of a charm's storage, as declared
+// in its metadata. Storage names follow the same rules as service
+// names.
+const StorageNameSnippet = "(?:[a-z][a-z0-9]*(?:-[a-z0-9]*[a-z][a-z0-9]*)*)"
+
+// IsValidStorageName returns whether name is a valid charm storage
+// name.
+func IsValidStorageName(name string) bool {
+	return matchStorageName(name)
+}
+
+// IsValidStorage returns whether id is a valid storage instance id: a
+// storage name and an ordinal, separated by a slash, such as "data/0".
+func IsValidStorage(id string) bool {
+	_, ok := matchStorage(id)
+	return ok
+}
+
+type StorageTag struct {
+	id string
+}
+
+func (t StorageTag) String() string { return t.Kind() + "-" + t.id }
+func (t StorageTag) Kind() string   { return StorageTagKind }
+func (t StorageTag) Id() string     { return unitTagSuffixToId(t.id) }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t StorageTag) AppendString(dst []byte) []byte {
+	return append(append(dst, StorageTagKind+KindSeparator...), t.id...)
+}
+
+// Name returns the name of the storage, such as "data" for the
+// storage instance "data/0".
+func (t StorageTag) Name() string {
+	if i := strings.LastIndex(t.id, "-"); i > 0 {
+		return t.id[:i]
+	}
+	return t.id
+}
+
+// Ordinal returns the ordinal of the storage instance among those with
+// the same name, such as 0 for the storage instance "data/0".
+func (t StorageTag) Ordinal() int {
+	n, _ := strconv.Atoi(t.id[strings.LastIndex(t.id, "-")+1:])
+	return n
+}
+
+// NewStorageTag returns the tag for the storage instance with the given
+// id. It will panic if the given storage id is not valid.
+func NewStorageTag(id string) StorageTag {
+	if !IsValidStorage(id) {
+		panic(fmt.Sprintf("%q is not a valid storage instance id", id))
+	}
+	// Replace only the last "/" with "-".
+	i := strings.LastIndex(id, "/")
+	return StorageTag{id: id[:i] + KindSeparator + id[i+1:]}
+}
+
+// ParseStorageTag parses a storage tag string.
+func ParseStorageTag(storageTag string) (StorageTag, error) {
+	tag, err := ParseTag(storageTag)
+	if err != nil {
+		return StorageTag{}, err
+	}
+	st, ok := tag.(StorageTag)
+	if !ok {
+		return StorageTag{}, invalidTagError(storageTag, StorageTagKind)
+	}
+	return st, nil
+}
+
+// StorageOwner returns the tag of the entity that owns the storage
+// instance s, derived from the given storage attachments. Storage ids
+// do not record their owner, so only the attachments of s are
+// considered; others are ignored. Storage attached to a single unit is
+// owned by that unit, and storage shared by several units of the same
+// application is owned by the application. It is an error if s has no
+// attachments or is attached to units of more than one application.
+func StorageOwner(s StorageTag, attachments ...StorageAttachmentTag) (Tag, error) {
+	var owner Tag
+	var unit UnitTag
+	for _, a := range attachments {
+		if a.Storage() != s {
+			continue
+		}
+		switch {
+		case owner == nil:
+			unit = a.Unit()
+			owner = unit
+		case a.Unit() == unit:
+		case UnitService(a.Unit().Id()) == UnitService(unit.Id()):
+			owner = NewApplicationTag(UnitService(unit.Id()))
+		default:
+			return nil, fmt.Errorf("cannot derive owner of storage %q: attached to units of more than one application", s.Id())
+		}
+	}
+	if owner == nil {
+		return nil, fmt.Errorf("cannot derive owner of storage %q: no attachments", s.Id())
+	}
+	return owner, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type storageSuite struct{}
+
+var _ = gc.Suite(&storageSuite{})
+
+var storageNameTests = []struct {
+	pattern string
+	valid   bool
+}{
+	{pattern: "data", valid: true},
+	{pattern: "shared-fs", valid: true},
+	{pattern: "db2", valid: true},
+	{pattern: "", valid: false},
+	{pattern: "2db", valid: false},
+	{pattern: "Data", valid: false},
+	{pattern: "data-", valid: false},
+	{pattern: "data-0", valid: false},
+	{pattern: "data_log", valid: false},
+	{pattern: "data/0", valid: false},
+}
+
+func (s *storageSuite) TestStorageNames(c *gc.C) {
+	for i, test := range storageNameTests {
+		c.Logf("test %d: %q", i, test.pattern)
+		c.Check(names.IsValidStorageName(test.pattern), gc.Equals, test.valid)
+	}
+}
+
+var storageIdTests = []struct {
+	id      string
+	valid   bool
+	tag     string
+	name    string
+	ordinal int
+}{
+	{id: "data/0", valid: true, tag: "storage-data-0", name: "data", ordinal: 0},
+	{id: "shared-fs/12", valid: true, tag: "storage-shared-fs-12", name: "shared-fs", ordinal: 12},
+	{id: "data"},
+	{id: "data/"},
+	{id: "data/01"},
+	{id: "data-0"},
+	{id: "0/0"},
+	{id: "data/0/1"},
+}
+
+func (s *storageSuite) TestStorageTag(c *gc.C) {
+	for i, test := range storageIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidStorage(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid storage instance id", test.id)
+			testStorageTag := func() { names.NewStorageTag(test.id) }
+			c.Check(testStorageTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewStorageTag(test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.Kind(), gc.Equals, names.StorageTagKind)
+		c.Check(tag.Name(), gc.Equals, test.name)
+		c.Check(tag.Ordinal(), gc.Equals, test.ordinal)
+	}
+}
+
+var parseStorageTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "storage-data-0",
+	expected: names.NewStorageTag("data/0"),
+}, {
+	tag: "storage-data",
+	err: names.InvalidTagError("storage-data", names.StorageTagKind),
+}, {
+	tag: "storage",
+	err: names.InvalidTagError("storage", ""),
+}, {
+	tag: "unit-data-0",
+	err: names.InvalidTagError("unit-data-0", names.StorageTagKind),
+}}
+
+func (s *storageSuite) TestParseStorageTag(c *gc.C) {
+	for i, t := range parseStorageTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseStorageTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
+
+func (s *storageSuite) TestStorageOwner(c *gc.C) {
+	data := names.NewStorageTag("data/0")
+	logs := names.NewStorageTag("logs/0")
+	attach := func(storage names.StorageTag, unit string) names.StorageAttachmentTag {
+		return names.NewStorageAttachmentTag(storage, names.NewUnitTag(unit))
+	}
+	for i, test := range []struct {
+		attachments []names.StorageAttachmentTag
+		owner       names.Tag
+		err         string
+	}{{
+		attachments: []names.StorageAttachmentTag{attach(data, "mysql/0")},
+		owner:       names.NewUnitTag("mysql/0"),
+	}, {
+		attachments: []names.StorageAttachmentTag{attach(logs, "wordpress/0"), attach(data, "mysql/0"), attach(data, "mysql/0")},
+		owner:       names.NewUnitTag("mysql/0"),
+	}, {
+		attachments: []names.StorageAttachmentTag{attach(data, "mysql/0"), attach(data, "mysql/1")},
+		owner:       names.NewApplicationTag("mysql"),
+	}, {
+		err: `cannot derive owner of storage "data/0": no attachments`,
+	}, {
+		attachments: []names.StorageAttachmentTag{attach(logs, "mysql/0")},
+		err:         `cannot derive owner of storage "data/0": no attachments`,
+	}, {
+		attachments: []names.StorageAttachmentTag{attach(data, "mysql/0"), attach(data, "mysql/1"), attach(data, "wordpress/0")},
+		err:         `cannot derive owner of storage "data/0": attached to units of more than one application`,
+	}} {
+		c.Logf("test %d", i)
+		owner, err := names.StorageOwner(data, test.attachments...)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			c.Check(owner, gc.IsNil)
+			continue
+		}
+		c.Check(err, gc.IsNil)
+		c.Check(owner, gc.Equals, test.owner)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const StorageAttachmentTagKind = "storageattachment"
+
+// AttachmentSeparator separates the id of the host from the id of the
+// attached entity in attachment ids, such as "mysql/0:data/0".
+const AttachmentSeparator = ":"
+
+// IsValidStorageAttachment returns whether id is a valid storage
+// attachment id: the name of a unit and the id of a storage instance
+// attached to it, separated by AttachmentSeparator, such as
+// "mysql/0:data/0".
+func IsValidStorageAttachment(id string) bool {
+	unit, storage, ok := splitAttachmentId(id)
+	return ok && IsValidUnit(unit) && IsValidStorage(storage)
+}
+
+// StorageAttachmentTag represents the attachment of a storage instance
+// to a unit.
+type StorageAttachmentTag struct {
+	unit    UnitTag
+	storage StorageTag
+}
+
+func (t StorageAttachmentTag) String() string { return t.Kind() + "-" + t.suffix() }
+func (t StorageAttachmentTag) Kind() string   { return StorageAttachmentTagKind }
+func (t StorageAttachmentTag) Id() string {
+	return t.unit.Id() + AttachmentSeparator + t.storage.Id()
+}
+
+func (t StorageAttachmentTag) suffix() string {
+	return t.unit.name + AttachmentSeparator + t.storage.id
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t StorageAttachmentTag) AppendString(dst []byte) []byte {
+	dst = append(dst, StorageAttachmentTagKind+KindSeparator...)
+	return append(append(append(dst, t.unit.name...), AttachmentSeparator...), t.storage.id...)
+}
+
+// Unit returns the tag of the unit to which the storage is attached.
+func (t StorageAttachmentTag) Unit() UnitTag { return t.unit }
+
+// Storage returns the tag of the attached storage instance.
+func (t StorageAttachmentTag) Storage() StorageTag { return t.storage }
+
+// NewStorageAttachmentTag returns the tag for the attachment of the
+// given storage instance to the given unit. It will panic if either tag
+// is the zero value.
+func NewStorageAttachmentTag(storage StorageTag, unit UnitTag) StorageAttachmentTag {
+	if storage == (StorageTag{}) || unit == (UnitTag{}) {
+		panic(fmt.Sprintf("cannot attach storage %q to unit %q", storage.Id(), unit.Id()))
+	}
+	return StorageAttachmentTag{unit: unit, storage: storage}
+}
+
+// ParseStorageAttachmentTag parses a storage attachment tag string.
+func ParseStorageAttachmentTag(storageAttachmentTag string) (StorageAttachmentTag, error) {
+	tag, err := ParseTag(storageAttachmentTag)
+	if err != nil {
+		return StorageAttachmentTag{}, err
+	}
+	st, ok := tag.(StorageAttachmentTag)
+	if !ok {
+		return StorageAttachmentTag{}, invalidTagError(storageAttachmentTag, StorageAttachmentTagKind)
+	}
+	return st, nil
+}
+
+// splitAttachmentId splits an attachment id, or the tag suffix of one,
+// into the ids of the host and the attached entity.
+func splitAttachmentId(id string) (host, attached string, ok bool) {
+	i := strings.Index(id, AttachmentSeparator)
+	if i < 0 {
+		return "", "", false
+	}
+	return id[:i], id[i+len(AttachmentSeparator):], true
+}
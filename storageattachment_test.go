@@ -0,0 +1,91 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type storageAttachmentSuite struct{}
+
+var _ = gc.Suite(&storageAttachmentSuite{})
+
+func (s *storageAttachmentSuite) TestStorageAttachmentTag(c *gc.C) {
+	storage := names.NewStorageTag("data/0")
+	unit := names.NewUnitTag("mysql/0")
+	tag := names.NewStorageAttachmentTag(storage, unit)
+	c.Check(tag.String(), gc.Equals, "storageattachment-mysql-0:data-0")
+	c.Check(tag.Kind(), gc.Equals, names.StorageAttachmentTagKind)
+	c.Check(tag.Id(), gc.Equals, "mysql/0:data/0")
+	c.Check(tag.Storage(), gc.Equals, storage)
+	c.Check(tag.Unit(), gc.Equals, unit)
+}
+
+func (s *storageAttachmentSuite) TestNewStorageAttachmentTagZero(c *gc.C) {
+	c.Check(func() {
+		names.NewStorageAttachmentTag(names.StorageTag{}, names.NewUnitTag("mysql/0"))
+	}, gc.PanicMatches, `cannot attach storage "" to unit "mysql/0"`)
+	c.Check(func() {
+		names.NewStorageAttachmentTag(names.NewStorageTag("data/0"), names.UnitTag{})
+	}, gc.PanicMatches, `cannot attach storage "data/0" to unit ""`)
+}
+
+var storageAttachmentIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "mysql/0:data/0", valid: true},
+	{id: "my-sql/10:shared-fs/3", valid: true},
+	{id: "mysql/0"},
+	{id: "mysql/0:"},
+	{id: ":data/0"},
+	{id: "data/0:mysql/0:"},
+	{id: "mysql:data/0"},
+	{id: "mysql/0:data"},
+	{id: "mysql/0:data/0:data/1"},
+	{id: "mysql-0:data-0"},
+}
+
+func (s *storageAttachmentSuite) TestIsValidStorageAttachment(c *gc.C) {
+	for i, test := range storageAttachmentIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidStorageAttachment(test.id), gc.Equals, test.valid)
+	}
+}
+
+var parseStorageAttachmentTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "storageattachment-mysql-0:data-0",
+	expected: names.NewStorageAttachmentTag(names.NewStorageTag("data/0"), names.NewUnitTag("mysql/0")),
+}, {
+	tag: "storageattachment-mysql-0:data",
+	err: names.InvalidTagError("storageattachment-mysql-0:data", names.StorageAttachmentTagKind),
+}, {
+	tag: "storageattachment",
+	err: names.InvalidTagError("storageattachment", ""),
+}, {
+	tag: "storage-data-0",
+	err: names.InvalidTagError("storage-data-0", names.StorageAttachmentTagKind),
+}}
+
+func (s *storageAttachmentSuite) TestParseStorageAttachmentTag(c *gc.C) {
+	for i, t := range parseStorageAttachmentTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseStorageAttachmentTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
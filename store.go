@@ -0,0 +1,137 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TagStore is implemented by indexes of tags. Implementations are
+// provided here, held in memory, and by the namesbolt package, held on
+// disk.
+type TagStore interface {
+	// Add adds the given tags to the store. Adding a tag that is
+	// already stored has no effect.
+	Add(tags ...Tag) error
+
+	// Remove removes the given tags from the store. Removing a tag
+	// that is not stored has no effect.
+	Remove(tags ...Tag) error
+
+	// Walk calls fn for each stored tag of the given kind whose id
+	// starts with idPrefix, ordered by kind and then by id. If kind is
+	// empty, tags of all kinds are visited. If fn returns an error,
+	// the walk stops and Walk returns that error.
+	Walk(kind, idPrefix string, fn func(Tag) error) error
+
+	// Count returns the number of stored tags that Walk would visit
+	// for the given kind and id prefix.
+	Count(kind, idPrefix string) (int, error)
+}
+
+// MemoryTagStore is a TagStore held in memory. The zero value is an
+// empty store ready to use, and a MemoryTagStore is safe for concurrent
+// use.
+type MemoryTagStore struct {
+	mu    sync.RWMutex
+	kinds map[string]map[string]Tag
+}
+
+var _ TagStore = (*MemoryTagStore)(nil)
+
+// Add implements TagStore.Add.
+func (s *MemoryTagStore) Add(tags ...Tag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.kinds == nil {
+		s.kinds = make(map[string]map[string]Tag)
+	}
+	for _, t := range tags {
+		ids := s.kinds[t.Kind()]
+		if ids == nil {
+			ids = make(map[string]Tag)
+			s.kinds[t.Kind()] = ids
+		}
+		ids[t.Id()] = t
+	}
+	return nil
+}
+
+// Remove implements TagStore.Remove.
+func (s *MemoryTagStore) Remove(tags ...Tag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range tags {
+		ids := s.kinds[t.Kind()]
+		delete(ids, t.Id())
+		if len(ids) == 0 {
+			delete(s.kinds, t.Kind())
+		}
+	}
+	return nil
+}
+
+// Walk implements TagStore.Walk. The matching tags are collected
+// before fn is first called, so fn may modify the store.
+func (s *MemoryTagStore) Walk(kind, idPrefix string, fn func(Tag) error) error {
+	for _, t := range s.matching(kind, idPrefix) {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Count implements TagStore.Count.
+func (s *MemoryTagStore) Count(kind, idPrefix string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n := 0
+	for k, ids := range s.kinds {
+		if kind != "" && k != kind {
+			continue
+		}
+		if idPrefix == "" {
+			n += len(ids)
+			continue
+		}
+		for id := range ids {
+			if strings.HasPrefix(id, idPrefix) {
+				n++
+			}
+		}
+	}
+	return n, nil
+}
+
+// matching returns the stored tags that Walk visits, in order.
+func (s *MemoryTagStore) matching(kind, idPrefix string) []Tag {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var kinds []string
+	if kind == "" {
+		for k := range s.kinds {
+			kinds = append(kinds, k)
+		}
+		sort.Strings(kinds)
+	} else {
+		kinds = []string{kind}
+	}
+	var tags []Tag
+	for _, k := range kinds {
+		ids := make([]string, 0, len(s.kinds[k]))
+		for id := range s.kinds[k] {
+			if strings.HasPrefix(id, idPrefix) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			tags = append(tags, s.kinds[k][id])
+		}
+	}
+	return tags
+}
@@ -0,0 +1,93 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"errors"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type storeSuite struct{}
+
+var _ = gc.Suite(&storeSuite{})
+
+func walkAll(c *gc.C, store names.TagStore, kind, idPrefix string) []names.Tag {
+	var tags []names.Tag
+	err := store.Walk(kind, idPrefix, func(t names.Tag) error {
+		tags = append(tags, t)
+		return nil
+	})
+	c.Assert(err, gc.IsNil)
+	return tags
+}
+
+func (s *storeSuite) TestMemoryTagStore(c *gc.C) {
+	var store names.MemoryTagStore
+	c.Check(walkAll(c, &store, "", ""), gc.HasLen, 0)
+
+	err := store.Add(
+		names.NewUnitTag("mysql/1"),
+		names.NewMachineTag("0"),
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("wordpress/0"),
+		names.NewMachineTag("0/lxc/0"),
+		names.NewUnitTag("mysql/0"),
+	)
+	c.Assert(err, gc.IsNil)
+
+	c.Check(walkAll(c, &store, "", ""), gc.DeepEquals, []names.Tag{
+		names.NewMachineTag("0"),
+		names.NewMachineTag("0/lxc/0"),
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("mysql/1"),
+		names.NewUnitTag("wordpress/0"),
+	})
+	c.Check(walkAll(c, &store, names.UnitTagKind, "mysql/"), gc.DeepEquals, []names.Tag{
+		names.NewUnitTag("mysql/0"),
+		names.NewUnitTag("mysql/1"),
+	})
+	c.Check(walkAll(c, &store, names.ServiceTagKind, ""), gc.HasLen, 0)
+
+	for _, test := range []struct {
+		kind, idPrefix string
+		count          int
+	}{
+		{"", "", 5},
+		{names.UnitTagKind, "", 3},
+		{names.UnitTagKind, "mysql/", 2},
+		{"", "0", 2},
+		{names.ServiceTagKind, "", 0},
+	} {
+		n, err := store.Count(test.kind, test.idPrefix)
+		c.Assert(err, gc.IsNil)
+		c.Check(n, gc.Equals, test.count, gc.Commentf("%q %q", test.kind, test.idPrefix))
+	}
+
+	err = store.Remove(names.NewUnitTag("mysql/0"), names.NewMachineTag("0/lxc/0"), names.NewServiceTag("mysql"))
+	c.Assert(err, gc.IsNil)
+	c.Check(walkAll(c, &store, "", ""), gc.DeepEquals, []names.Tag{
+		names.NewMachineTag("0"),
+		names.NewUnitTag("mysql/1"),
+		names.NewUnitTag("wordpress/0"),
+	})
+}
+
+func (s *storeSuite) TestMemoryTagStoreWalkError(c *gc.C) {
+	var store names.MemoryTagStore
+	err := store.Add(names.NewMachineTag("0"), names.NewMachineTag("1"))
+	c.Assert(err, gc.IsNil)
+	visited := 0
+	err = store.Walk("", "", func(t names.Tag) error {
+		visited++
+		// Modifying the store while walking is allowed.
+		c.Assert(store.Remove(t), gc.IsNil)
+		return errors.New("stop")
+	})
+	c.Check(err, gc.ErrorMatches, "stop")
+	c.Check(visited, gc.Equals, 1)
+	c.Check(walkAll(c, &store, "", ""), gc.DeepEquals, []names.Tag{names.NewMachineTag("1")})
+}
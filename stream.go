@@ -0,0 +1,109 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// MaxStreamTagLength holds the greatest length of a tag string that
+// ReadTag will accept, protecting readers from corrupt or hostile
+// length prefixes.
+const MaxStreamTagLength = 4096
+
+// MaxStreamBatchLength holds the greatest number of tags in a batch
+// that ReadTags will accept.
+const MaxStreamBatchLength = 1 << 20
+
+// WriteTag writes t to w as its string representation prefixed by its
+// length as an unsigned varint.
+func WriteTag(w io.Writer, t Tag) error {
+	_, err := w.Write(appendStreamTag(nil, t))
+	return err
+}
+
+// WriteTags writes a batch of tags to w as the number of tags, as an
+// unsigned varint, followed by each tag as written by WriteTag. The
+// batch is written with a single call to w.Write.
+func WriteTags(w io.Writer, tags []Tag) error {
+	buf := binary.AppendUvarint(nil, uint64(len(tags)))
+	for _, t := range tags {
+		buf = appendStreamTag(buf, t)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func appendStreamTag(dst []byte, t Tag) []byte {
+	s := t.String()
+	dst = binary.AppendUvarint(dst, uint64(len(s)))
+	return append(dst, s...)
+}
+
+// ReadTag reads and validates a tag written by WriteTag. It reads no
+// further than the end of the tag. ReadTag returns io.EOF only if
+// there are no more tags in r; if r ends part way through a tag, it
+// returns io.ErrUnexpectedEOF.
+func ReadTag(r io.Reader) (Tag, error) {
+	n, err := readUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > MaxStreamTagLength {
+		return nil, fmt.Errorf("tag length %d exceeds maximum of %d", n, MaxStreamTagLength)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return ParseTag(string(buf))
+}
+
+// ReadTags reads and validates a batch of tags written by WriteTags.
+// It returns io.EOF only if there are no more batches in r.
+func ReadTags(r io.Reader) ([]Tag, error) {
+	n, err := readUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > MaxStreamBatchLength {
+		return nil, fmt.Errorf("tag batch length %d exceeds maximum of %d", n, MaxStreamBatchLength)
+	}
+	tags := make([]Tag, n)
+	for i := range tags {
+		if tags[i], err = ReadTag(r); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+	}
+	return tags, nil
+}
+
+// readUvarint reads an unsigned varint from r one byte at a time, so
+// that nothing following it is consumed.
+func readUvarint(r io.Reader) (uint64, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = byteReader{r}
+	}
+	return binary.ReadUvarint(br)
+}
+
+type byteReader struct {
+	r io.Reader
+}
+
+func (r byteReader) ReadByte() (byte, error) {
+	var b [1]byte
+	_, err := io.ReadFull(r.r, b[:])
+	return b[0], err
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing/iotest"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type streamSuite struct{}
+
+var _ = gc.Suite(&streamSuite{})
+
+var streamTags = []names.Tag{
+	names.NewUnitTag("mysql/0"),
+	names.NewMachineTag("0/lxc/1"),
+	names.NewUserTag("bob@local"),
+	names.NewRelationTag("wordpress:db mysql:server"),
+}
+
+func (s *streamSuite) TestWriteReadTag(c *gc.C) {
+	var buf bytes.Buffer
+	for _, t := range streamTags {
+		c.Assert(names.WriteTag(&buf, t), gc.IsNil)
+	}
+	c.Check(buf.Bytes()[:13], gc.DeepEquals, []byte("\x0cunit-mysql-0"))
+
+	// Reading one byte at a time checks that nothing beyond each
+	// tag is consumed.
+	r := iotest.OneByteReader(&buf)
+	for _, expect := range streamTags {
+		t, err := names.ReadTag(r)
+		c.Assert(err, gc.IsNil)
+		c.Check(t, gc.Equals, expect)
+	}
+	_, err := names.ReadTag(r)
+	c.Check(err, gc.Equals, io.EOF)
+}
+
+func (s *streamSuite) TestWriteReadTags(c *gc.C) {
+	var buf bytes.Buffer
+	c.Assert(names.WriteTags(&buf, streamTags), gc.IsNil)
+	c.Assert(names.WriteTags(&buf, nil), gc.IsNil)
+	c.Assert(names.WriteTags(&buf, streamTags[:1]), gc.IsNil)
+
+	tags, err := names.ReadTags(&buf)
+	c.Assert(err, gc.IsNil)
+	c.Check(tags, gc.DeepEquals, streamTags)
+	tags, err = names.ReadTags(&buf)
+	c.Assert(err, gc.IsNil)
+	c.Check(tags, gc.HasLen, 0)
+	tags, err = names.ReadTags(&buf)
+	c.Assert(err, gc.IsNil)
+	c.Check(tags, gc.DeepEquals, streamTags[:1])
+	_, err = names.ReadTags(&buf)
+	c.Check(err, gc.Equals, io.EOF)
+}
+
+func (s *streamSuite) TestReadErrors(c *gc.C) {
+	_, err := names.ReadTag(strings.NewReader("\x0cunit-mysql"))
+	c.Check(err, gc.Equals, io.ErrUnexpectedEOF)
+	_, err = names.ReadTag(strings.NewReader("\x0aunit-mysql"))
+	c.Check(err, gc.ErrorMatches, `"unit-mysql" is not a valid unit tag`)
+	_, err = names.ReadTag(strings.NewReader("\xff\xff\x03"))
+	c.Check(err, gc.ErrorMatches, `tag length 65535 exceeds maximum of 4096`)
+	_, err = names.ReadTags(strings.NewReader("\x02\x09machine-0"))
+	c.Check(err, gc.Equals, io.ErrUnexpectedEOF)
+	_, err = names.ReadTags(strings.NewReader("\xff\xff\xff\x0f"))
+	c.Check(err, gc.ErrorMatches, `tag batch length \d+ exceeds maximum of 1048576`)
+}
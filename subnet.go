@@ -0,0 +1,73 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"net"
+)
+
+const SubnetTagKind = "subnet"
+
+// IsValidSubnet returns whether cidr is the canonical form of an IPv4
+// or IPv6 subnet in CIDR notation, such as "10.0.0.0/24" or
+// "2001:db8::/32": its network address, with no host bits set, and
+// prefix length, with IPv6 addresses in their shortest form. These are
+// the ids that ParseTag accepts. NewSubnetTag accepts any subnet in
+// CIDR notation, such as "10.0.0.1/24", and canonicalizes it.
+func IsValidSubnet(cidr string) bool {
+	canonical, ok := canonicalSubnet(cidr)
+	return ok && canonical == cidr
+}
+
+// canonicalSubnet returns the canonical form of the subnet cidr: its
+// network address and prefix length, with IPv6 addresses in their
+// shortest form.
+func canonicalSubnet(cidr string) (string, bool) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", false
+	}
+	return ipNet.String(), true
+}
+
+type SubnetTag struct {
+	cidr string
+}
+
+func (t SubnetTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t SubnetTag) Kind() string   { return SubnetTagKind }
+func (t SubnetTag) Id() string     { return t.cidr }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t SubnetTag) AppendString(dst []byte) []byte {
+	return append(append(dst, SubnetTagKind+KindSeparator...), t.cidr...)
+}
+
+// NewSubnetTag returns the tag of the subnet with the given CIDR. The
+// tag's id is the canonical form of the subnet, so that, for example,
+// "10.0.0.1/24" yields the tag "subnet-10.0.0.0/24". It will panic if
+// the given CIDR is not valid.
+func NewSubnetTag(cidr string) SubnetTag {
+	canonical, ok := canonicalSubnet(cidr)
+	if !ok {
+		panic(fmt.Sprintf("%q is not a valid subnet CIDR", cidr))
+	}
+	return SubnetTag{cidr: canonical}
+}
+
+// ParseSubnetTag parses a subnet tag string. Only tags holding the
+// canonical form of a subnet are valid.
+func ParseSubnetTag(subnetTag string) (SubnetTag, error) {
+	tag, err := ParseTag(subnetTag)
+	if err != nil {
+		return SubnetTag{}, err
+	}
+	st, ok := tag.(SubnetTag)
+	if !ok {
+		return SubnetTag{}, invalidTagError(subnetTag, SubnetTagKind)
+	}
+	return st, nil
+}
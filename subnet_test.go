@@ -0,0 +1,114 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type subnetSuite struct{}
+
+var _ = gc.Suite(&subnetSuite{})
+
+var subnetTests = []struct {
+	cidr  string
+	valid bool
+	id    string
+}{
+	{cidr: "10.0.0.0/24", valid: true, id: "10.0.0.0/24"},
+	{cidr: "0.0.0.0/0", valid: true, id: "0.0.0.0/0"},
+	{cidr: "192.168.1.1/32", valid: true, id: "192.168.1.1/32"},
+	{cidr: "2001:db8::/32", valid: true, id: "2001:db8::/32"},
+	{cidr: "::/0", valid: true, id: "::/0"},
+	{cidr: ""},
+	{cidr: "10.0.0.0"},
+	{cidr: "10.0.0.0/33"},
+	{cidr: "10.0.0/24"},
+	{cidr: "010.0.0.0/24"},
+	{cidr: "2001:db8::/129"},
+	{cidr: "fe80::1%eth0/64"},
+	{cidr: "foo/24"},
+}
+
+func (s *subnetSuite) TestNonCanonicalSubnet(c *gc.C) {
+	for i, test := range []struct {
+		cidr string
+		id   string
+	}{
+		{"10.0.0.1/8", "10.0.0.0/8"},
+		{"10.0.0.1/24", "10.0.0.0/24"},
+		{"2001:DB8:0:0::1/64", "2001:db8::/64"},
+		{"2001:DB8::/32", "2001:db8::/32"},
+	} {
+		c.Logf("test %d: %q", i, test.cidr)
+		// The validator and the parser agree in rejecting the
+		// non-canonical form, which NewSubnetTag canonicalizes.
+		c.Check(names.IsValidSubnet(test.cidr), gc.Equals, false)
+		_, err := names.ParseTag(names.SubnetTagKind + "-" + test.cidr)
+		c.Check(err, gc.NotNil)
+		c.Check(names.NewSubnetTag(test.cidr).Id(), gc.Equals, test.id)
+		c.Check(names.IsValidSubnet(test.id), gc.Equals, true)
+	}
+}
+
+func (s *subnetSuite) TestSubnetTag(c *gc.C) {
+	for i, test := range subnetTests {
+		c.Logf("test %d: %q", i, test.cidr)
+		c.Check(names.IsValidSubnet(test.cidr), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid subnet CIDR", test.cidr)
+			testSubnetTag := func() { names.NewSubnetTag(test.cidr) }
+			c.Check(testSubnetTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewSubnetTag(test.cidr)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.String(), gc.Equals, names.SubnetTagKind+"-"+test.id)
+		parsed, err := names.ParseSubnetTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseSubnetTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "subnet-10.0.0.0/8",
+	expected: names.NewSubnetTag("10.0.0.0/8"),
+}, {
+	tag: "subnet-10.0.0.0/8/1",
+	err: names.InvalidTagError("subnet-10.0.0.0/8/1", names.SubnetTagKind),
+}, {
+	tag: "subnet-2001:DB8::/32",
+	err: names.InvalidTagError("subnet-2001:DB8::/32", names.SubnetTagKind),
+}, {
+	tag: "subnet",
+	err: names.InvalidTagError("subnet", ""),
+}, {
+	tag: "space-dmz",
+	err: names.InvalidTagError("space-dmz", names.SubnetTagKind),
+}}
+
+func (s *subnetSuite) TestParseSubnetTag(c *gc.C) {
+	for i, t := range parseSubnetTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseSubnetTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
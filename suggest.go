@@ -0,0 +1,180 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"strings"
+)
+
+// SuggestValidName returns valid ids of the given kind that are close
+// to input, most plausible first, for interactive tools to offer when
+// input fails validation. Candidates are formed by folding case,
+// replacing or dropping invalid characters and truncating at the
+// first unusable character. If input is already valid, it is the only
+// suggestion; if no candidate can be found, or the kind has no
+// suggestion rules, the result is empty.
+func SuggestValidName(kind, input string) []string {
+	var valid func(string) bool
+	var candidates []string
+	switch kind {
+	case ServiceTagKind, ApplicationTagKind:
+		valid = IsValidService
+		candidates = serviceCandidates(input)
+	case UnitTagKind:
+		valid = IsValidUnit
+		candidates = unitCandidates(input)
+	case MachineTagKind:
+		valid = IsValidMachine
+		candidates = machineCandidates(input)
+	case UserTagKind:
+		valid = IsValidUser
+		candidates = userCandidates(input)
+	case NetworkTagKind:
+		valid = IsValidNetwork
+		candidates = hyphenatedCandidates(strings.ToLower(input), isLowerAlnum)
+	case SpaceTagKind:
+		valid = IsValidSpace
+		for _, c := range hyphenatedCandidates(strings.ToLower(input), isLowerAlnum) {
+			// Space names cannot start with a digit.
+			candidates = append(candidates, strings.TrimLeft(c, "0123456789-"))
+		}
+	default:
+		return nil
+	}
+	if valid(input) {
+		return []string{input}
+	}
+	var suggestions []string
+	seen := make(map[string]bool)
+	for _, candidate := range candidates {
+		if valid(candidate) && !seen[candidate] {
+			seen[candidate] = true
+			suggestions = append(suggestions, candidate)
+		}
+	}
+	return suggestions
+}
+
+// hyphenatedCandidates returns candidate names built from s by
+// replacing runs of characters for which ok is false with a single
+// hyphen, by dropping them, and by truncating at the first of them.
+func hyphenatedCandidates(s string, ok func(rune) bool) []string {
+	var replaced, dropped []rune
+	truncated := ""
+	for i, r := range s {
+		if ok(r) {
+			replaced = append(replaced, r)
+			dropped = append(dropped, r)
+			continue
+		}
+		if truncated == "" {
+			truncated = s[:i]
+		}
+		if len(replaced) > 0 && replaced[len(replaced)-1] != '-' {
+			replaced = append(replaced, '-')
+		}
+	}
+	trim := func(s string) string { return strings.Trim(s, "-") }
+	return []string{trim(string(replaced)), trim(string(dropped)), trim(truncated)}
+}
+
+// serviceCandidates returns candidate service names for s.
+func serviceCandidates(s string) []string {
+	var candidates []string
+	for _, c := range hyphenatedCandidates(strings.ToLower(s), isLowerAlnum) {
+		// Service names cannot start with a digit, nor have
+		// hyphenated parts made only of digits.
+		c = strings.TrimLeft(c, "0123456789-")
+		parts := strings.Split(c, "-")
+		merged := parts[:1]
+		for _, part := range parts[1:] {
+			if strings.Trim(part, "0123456789") == "" {
+				merged[len(merged)-1] += part
+			} else {
+				merged = append(merged, part)
+			}
+		}
+		candidates = append(candidates, strings.Join(merged, "-"))
+	}
+	return candidates
+}
+
+// unitCandidates returns candidate unit names for s.
+func unitCandidates(s string) []string {
+	service, number := s, "0"
+	if i := strings.LastIndexAny(s, "/-_ "); i >= 0 {
+		if n := strings.TrimLeft(s[i+1:], "0"); isNumber(s[i+1:]) {
+			service, number = s[:i], n
+			if number == "" {
+				number = "0"
+			}
+		}
+	}
+	var candidates []string
+	for _, c := range serviceCandidates(service) {
+		candidates = append(candidates, c+"/"+number)
+	}
+	return candidates
+}
+
+// machineCandidates returns candidate machine ids for s.
+func machineCandidates(s string) []string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, MachineTagKind+"-")
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '/' || r == '-' || r == ' '
+	})
+	for i, part := range parts {
+		if isNumber(part) {
+			if parts[i] = strings.TrimLeft(part, "0"); parts[i] == "" {
+				parts[i] = "0"
+			}
+		}
+	}
+	candidates := []string{strings.Join(parts, "/")}
+	// Truncate to the longest valid machine or container.
+	for n := len(parts) - 1; n > 0; n-- {
+		candidates = append(candidates, strings.Join(parts[:n], "/"))
+	}
+	return candidates
+}
+
+// userCandidates returns candidate user ids for s.
+func userCandidates(s string) []string {
+	name, provider := s, ""
+	if i := strings.Index(s, "@"); i >= 0 {
+		name, provider = s[:i], s[i+1:]
+	}
+	userOk := func(r rune) bool { return isASCIIAlnum(r) || r == '.' }
+	trim := func(s string) string { return strings.Trim(s, ".-") }
+	var candidates []string
+	for _, n := range hyphenatedCandidates(name, userOk) {
+		n = trim(n)
+		if provider == "" {
+			candidates = append(candidates, n)
+			continue
+		}
+		for _, p := range hyphenatedCandidates(provider, userOk) {
+			candidates = append(candidates, n+"@"+trim(p))
+		}
+		candidates = append(candidates, n)
+	}
+	return candidates
+}
+
+func isLowerAlnum(r rune) bool {
+	return 'a' <= r && r <= 'z' || '0' <= r && r <= '9'
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
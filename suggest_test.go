@@ -0,0 +1,50 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type suggestSuite struct{}
+
+var _ = gc.Suite(&suggestSuite{})
+
+var suggestTests = []struct {
+	kind   string
+	input  string
+	expect []string
+}{
+	{names.ServiceTagKind, "wordpress", []string{"wordpress"}},
+	{names.ServiceTagKind, "WordPress", []string{"wordpress"}},
+	{names.ServiceTagKind, "my_sql server", []string{"my-sql-server", "mysqlserver", "my"}},
+	{names.ApplicationTagKind, "my_sql server", []string{"my-sql-server", "mysqlserver", "my"}},
+	{names.ServiceTagKind, "mysql-5", []string{"mysql5", "mysql"}},
+	{names.ServiceTagKind, "42-mysql", []string{"mysql"}},
+	{names.ServiceTagKind, "mysql!", []string{"mysql"}},
+	{names.ServiceTagKind, "!!!", nil},
+	{names.UnitTagKind, "mysql/0", []string{"mysql/0"}},
+	{names.UnitTagKind, "MySQL-01", []string{"mysql/1"}},
+	{names.UnitTagKind, "rabbitmq_server/3", []string{"rabbitmq-server/3", "rabbitmqserver/3", "rabbitmq/3"}},
+	{names.UnitTagKind, "mysql", []string{"mysql/0"}},
+	{names.MachineTagKind, "03", []string{"3"}},
+	{names.MachineTagKind, "machine-0-lxc-1", []string{"0/lxc/1", "0"}},
+	{names.MachineTagKind, "0/LXC/01", []string{"0/lxc/1", "0"}},
+	{names.MachineTagKind, "0/lxc/1/bad", []string{"0/lxc/1", "0"}},
+	{names.UserTagKind, "bob", []string{"bob"}},
+	{names.UserTagKind, "bob_smith", []string{"bob-smith", "bobsmith", "bob"}},
+	{names.UserTagKind, "bob+1@local!", []string{"bob-1@local", "bob-1", "bob1@local", "bob1", "bob@local", "bob"}},
+	{names.NetworkTagKind, "My Net", []string{"my-net", "mynet", "my"}},
+	{names.SpaceTagKind, "2nd DMZ", []string{"nd-dmz", "nddmz", "nd"}},
+	{names.EnvironTagKind, "foo", nil},
+}
+
+func (s *suggestSuite) TestSuggestValidName(c *gc.C) {
+	for i, test := range suggestTests {
+		c.Logf("test %d: %s %q", i, test.kind, test.input)
+		c.Check(names.SuggestValidName(test.kind, test.input), gc.DeepEquals, test.expect)
+	}
+}
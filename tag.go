@@ -8,6 +8,10 @@ import (
 	"strings"
 )
 
+// KindSeparator separates the kind of a tag from the rest of its
+// string representation.
+const KindSeparator = "-"
+
 // A Tag tags things that are taggable.
 type Tag interface {
 	// Kind returns the kind of the tag.
@@ -24,10 +28,25 @@ type Tag interface {
 	fmt.Stringer // all Tags should be able to print themselves
 }
 
+// Tagger is implemented by entities, such as API parameter structs,
+// that expose their tag as a string.
+type Tagger interface {
+	Tag() string
+}
+
+// TagFromTagger parses and validates the tag exposed by the given
+// entity.
+func TagFromTagger(entity Tagger) (Tag, error) {
+	if entity == nil {
+		return nil, fmt.Errorf("no entity to take tag from")
+	}
+	return ParseTag(entity.Tag())
+}
+
 // TagKind returns one of the *TagKind constants for the given tag, or
 // an error if none matches.
 func TagKind(tag string) (string, error) {
-	i := strings.Index(tag, "-")
+	i := strings.Index(tag, KindSeparator)
 	if i <= 0 || !validKinds(tag[:i]) {
 		return "", fmt.Errorf("%q is not a valid tag", tag)
 	}
@@ -36,7 +55,18 @@ func TagKind(tag string) (string, error) {
 
 func validKinds(kind string) bool {
 	switch kind {
-	case UnitTagKind, MachineTagKind, ServiceTagKind, EnvironTagKind, UserTagKind, RelationTagKind, NetworkTagKind, ActionTagKind, ActionResultTagKind:
+	case UnitTagKind, MachineTagKind, ServiceTagKind, EnvironTagKind, UserTagKind, RelationTagKind, NetworkTagKind, ActionTagKind, ActionResultTagKind,
+		VolumeTagKind, FilesystemTagKind, StorageTagKind, StorageAttachmentTagKind,
+		VolumeAttachmentTagKind, FilesystemAttachmentTagKind, SpaceTagKind,
+		SubnetTagKind, IPAddressTagKind, ModelTagKind, ControllerTagKind,
+		ControllerAgentTagKind, CloudTagKind, CloudCredentialTagKind,
+		ApplicationTagKind, ApplicationOfferTagKind, RemoteApplicationTagKind,
+		RemoteRelationTagKind, OperationTagKind, SecretTagKind,
+		SecretBackendTagKind, CharmTagKind, ResourceTagKind, MetricBatchTagKind,
+		InstanceTagKind, AvailabilityZoneTagKind, CloudRegionTagKind,
+		ImageTagKind, SSHKeyTagKind, CertificateTagKind, BackupTagKind,
+		UpgradeTagKind, MigrationTagKind, LeaseTagKind, AuditEntryTagKind,
+		TokenTagKind:
 		return true
 	}
 	return false
@@ -47,67 +77,347 @@ func splitTag(tag string) (string, string, error) {
 	if err != nil {
 		return "", "", err
 	}
-	return kind, tag[len(kind)+1:], nil
+	return kind, tag[len(kind)+len(KindSeparator):], nil
 }
 
-// ParseTag parses a string representation into a Tag.
+// ParseTag parses a string representation into a Tag. If a default
+// parser has been set with SetDefaultParser, the tag is parsed by it,
+// and so are the tags parsed by every Parse*Tag function.
 func ParseTag(tag string) (Tag, error) {
+	if p := defaultParser.Load(); p != nil {
+		return p.ParseTag(tag)
+	}
+	return parseTag(tag)
+}
+
+// parseTag parses a string representation into a Tag, ignoring any
+// default parser.
+func parseTag(tag string) (Tag, error) {
 	kind, id, err := splitTag(tag)
 	if err != nil {
 		return nil, invalidTagError(tag, "")
 	}
+	t, ok := parseTagSuffix(kind, id)
+	if !ok {
+		return nil, invalidTagError(tag, kind)
+	}
+	return t, nil
+}
+
+// parseTagSuffix returns the tag of the given kind whose string
+// representation ends with the given suffix, and whether the kind is
+// known and the suffix valid for it. The kind is only compared with
+// the known kinds, and is not retained.
+func parseTagSuffix(kind, id string) (Tag, bool) {
 	switch kind {
 	case UnitTagKind:
 		id = unitTagSuffixToId(id)
 		if !IsValidUnit(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
 		}
-		return NewUnitTag(id), nil
+		return NewUnitTag(id), true
 	case MachineTagKind:
 		id = machineTagSuffixToId(id)
 		if !IsValidMachine(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
 		}
-		return NewMachineTag(id), nil
+		return NewMachineTag(id), true
 	case ServiceTagKind:
 		if !IsValidService(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
 		}
-		return NewServiceTag(id), nil
+		return NewServiceTag(id), true
 	case UserTagKind:
 		if !IsValidUser(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
 		}
-		return NewUserTag(id), nil
+		return NewUserTag(id), true
 	case EnvironTagKind:
 		if !IsValidEnvironment(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
+		}
+		return NewEnvironTag(id), true
+	case ModelTagKind:
+		if !IsValidModel(id) {
+			return nil, false
 		}
-		return NewEnvironTag(id), nil
+		return NewModelTag(id), true
 	case RelationTagKind:
 		id = relationTagSuffixToKey(id)
 		if !IsValidRelation(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
 		}
-		return NewRelationTag(id), nil
+		return NewRelationTag(id), true
 	case NetworkTagKind:
 		if !IsValidNetwork(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
 		}
-		return NewNetworkTag(id), nil
+		return NewNetworkTag(id), true
 	case ActionTagKind:
 		if !IsValidAction(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
 		}
-		return NewActionTag(id), nil
+		return NewActionTag(id), true
 	case ActionResultTagKind:
 		if !IsValidActionResult(id) {
-			return nil, invalidTagError(tag, kind)
+			return nil, false
+		}
+		return NewActionResultTag(id), true
+	case VolumeTagKind:
+		id = volumeTagSuffixToId(id)
+		if !IsValidVolume(id) {
+			return nil, false
+		}
+		return NewVolumeTag(id), true
+	case FilesystemTagKind:
+		id = volumeTagSuffixToId(id)
+		if !IsValidFilesystem(id) {
+			return nil, false
+		}
+		return NewFilesystemTag(id), true
+	case StorageTagKind:
+		id = unitTagSuffixToId(id)
+		if !IsValidStorage(id) {
+			return nil, false
+		}
+		return NewStorageTag(id), true
+	case StorageAttachmentTagKind:
+		unit, storage, ok := splitAttachmentId(id)
+		unit, storage = unitTagSuffixToId(unit), unitTagSuffixToId(storage)
+		if !ok || !IsValidUnit(unit) || !IsValidStorage(storage) {
+			return nil, false
+		}
+		return NewStorageAttachmentTag(NewStorageTag(storage), NewUnitTag(unit)), true
+	case VolumeAttachmentTagKind:
+		machine, volume, ok := splitAttachmentId(id)
+		machine, volume = machineTagSuffixToId(machine), volumeTagSuffixToId(volume)
+		if !ok || !IsValidMachine(machine) || !IsValidVolume(volume) {
+			return nil, false
+		}
+		return NewVolumeAttachmentTag(NewMachineTag(machine), NewVolumeTag(volume)), true
+	case FilesystemAttachmentTagKind:
+		hostSuffix, filesystem, ok := splitAttachmentId(id)
+		filesystem = volumeTagSuffixToId(filesystem)
+		host, hostOk := hostTag(hostTagSuffixToId(hostSuffix))
+		if !ok || !hostOk || !IsValidFilesystem(filesystem) {
+			return nil, false
+		}
+		return NewFilesystemAttachmentTag(host, NewFilesystemTag(filesystem)), true
+	case SpaceTagKind:
+		if !IsValidSpace(id) {
+			return nil, false
+		}
+		return NewSpaceTag(id), true
+	case SubnetTagKind:
+		if !IsValidSubnet(id) {
+			return nil, false
+		}
+		return NewSubnetTag(id), true
+	case IPAddressTagKind:
+		if canonical, ok := canonicalIPAddress(id); !ok || canonical != id {
+			return nil, false
+		}
+		return NewIPAddressTag(id), true
+	case ControllerTagKind:
+		if !IsValidController(id) {
+			return nil, false
+		}
+		return NewControllerTag(id), true
+	case ControllerAgentTagKind:
+		if !IsValidControllerAgent(id) {
+			return nil, false
+		}
+		return NewControllerAgentTag(id), true
+	case CloudTagKind:
+		if !IsValidCloud(id) {
+			return nil, false
+		}
+		return NewCloudTag(id), true
+	case CloudCredentialTagKind:
+		t, ok := splitCloudCredentialId(id, cloudCredentialTagSeparator)
+		if !ok {
+			return nil, false
+		}
+		return t, true
+	case ApplicationTagKind:
+		if !IsValidApplication(id) {
+			return nil, false
+		}
+		return NewApplicationTag(id), true
+	case ApplicationOfferTagKind:
+		if !IsValidApplicationOffer(id) {
+			return nil, false
+		}
+		return NewApplicationOfferTag(id), true
+	case RemoteApplicationTagKind:
+		if !IsValidRemoteApplication(id) {
+			return nil, false
+		}
+		return NewRemoteApplicationTag(id), true
+	case RemoteRelationTagKind:
+		id = relationTagSuffixToKey(id)
+		if !IsValidRemoteRelation(id) {
+			return nil, false
+		}
+		return NewRemoteRelationTag(id), true
+	case OperationTagKind:
+		if !IsValidOperation(id) {
+			return nil, false
 		}
-		return NewActionResultTag(id), nil
+		return NewOperationTag(id), true
+	case SecretTagKind:
+		if !IsValidSecret(id) {
+			return nil, false
+		}
+		return NewSecretTag(id), true
+	case SecretBackendTagKind:
+		if !IsValidSecretBackend(id) {
+			return nil, false
+		}
+		return NewSecretBackendTag(id), true
+	case CharmTagKind:
+		if !IsValidCharmURL(id) {
+			return nil, false
+		}
+		return NewCharmTag(id), true
+	case ResourceTagKind:
+		if !IsValidResource(id) {
+			return nil, false
+		}
+		return NewResourceTag(id), true
+	case MetricBatchTagKind:
+		if !IsValidMetricBatch(id) {
+			return nil, false
+		}
+		return NewMetricBatchTag(id), true
+	case InstanceTagKind:
+		if !IsValidInstance(id) {
+			return nil, false
+		}
+		return NewInstanceTag(id), true
+	case AvailabilityZoneTagKind:
+		if !IsValidAvailabilityZone(id) {
+			return nil, false
+		}
+		return NewAvailabilityZoneTag(id), true
+	case CloudRegionTagKind:
+		t, ok := splitCloudRegionId(id, cloudRegionTagSeparator)
+		if !ok {
+			return nil, false
+		}
+		return t, true
+	case ImageTagKind:
+		if !IsValidImage(id) {
+			return nil, false
+		}
+		return NewImageTag(id), true
+	case SSHKeyTagKind:
+		if canonical, ok := canonicalSSHKeyFingerprint(id); !ok || canonical != id {
+			return nil, false
+		}
+		return NewSSHKeyTag(id), true
+	case CertificateTagKind:
+		if canonical, ok := canonicalCertificateId(id); !ok || canonical != id {
+			return nil, false
+		}
+		return NewCertificateTag(id), true
+	case BackupTagKind:
+		if !IsValidBackup(id) {
+			return nil, false
+		}
+		return NewBackupTag(id), true
+	case UpgradeTagKind:
+		if !IsValidUpgrade(id) {
+			return nil, false
+		}
+		return NewUpgradeTag(id), true
+	case MigrationTagKind:
+		if !IsValidMigration(id) {
+			return nil, false
+		}
+		return NewMigrationTag(id), true
+	case LeaseTagKind:
+		t, ok := splitLeaseId(id, leaseTagSeparator)
+		if !ok {
+			return nil, false
+		}
+		return t, true
+	case AuditEntryTagKind:
+		if !IsValidAuditEntry(id) {
+			return nil, false
+		}
+		return NewAuditEntryTag(id), true
+	case TokenTagKind:
+		if !IsValidToken(id) {
+			return nil, false
+		}
+		return NewTokenTag(id), true
 	default:
-		return nil, invalidTagError(tag, "")
+		return nil, false
+	}
+}
+
+// TagFromKindId returns the tag of the given kind with the given id,
+// or an error if id is not a valid id for that kind of tag.
+func TagFromKindId(kind, id string) (Tag, error) {
+	if !validKinds(kind) {
+		return nil, fmt.Errorf("%q is not a valid tag kind", kind)
+	}
+	t, err := parseTag(kind + KindSeparator + tagSuffix(kind, id))
+	if err != nil || t.Id() != id {
+		return nil, fmt.Errorf("%q is not a valid %s id", id, kind)
+	}
+	return t, nil
+}
+
+// AppendKindId appends the string representation of the tag of the
+// given kind with the given id to dst and returns the extended buffer.
+// Neither kind nor id is validated; use TagFromKindId to check them.
+func AppendKindId(dst []byte, kind, id string) []byte {
+	dst = append(dst, kind...)
+	dst = append(dst, KindSeparator...)
+	return append(dst, tagSuffix(kind, id)...)
+}
+
+// tagSuffix returns the portion of the string representation of a tag
+// of the given kind that follows the kind, for a tag with the given id.
+// It is the inverse of the conversions made by ParseTag.
+func tagSuffix(kind, id string) string {
+	switch kind {
+	case UnitTagKind, StorageTagKind:
+		// Replace only the last "/" with "-".
+		if i := strings.LastIndex(id, "/"); i > 0 {
+			id = id[:i] + KindSeparator + id[i+1:]
+		}
+	case MachineTagKind, VolumeTagKind, FilesystemTagKind:
+		id = strings.Replace(id, "/", KindSeparator, -1)
+	case StorageAttachmentTagKind:
+		if unit, storage, ok := splitAttachmentId(id); ok {
+			id = tagSuffix(UnitTagKind, unit) + AttachmentSeparator + tagSuffix(StorageTagKind, storage)
+		}
+	case VolumeAttachmentTagKind:
+		if machine, volume, ok := splitAttachmentId(id); ok {
+			id = tagSuffix(MachineTagKind, machine) + AttachmentSeparator + tagSuffix(VolumeTagKind, volume)
+		}
+	case FilesystemAttachmentTagKind:
+		if host, filesystem, ok := splitAttachmentId(id); ok {
+			hostKind := UnitTagKind
+			if t, ok := hostTag(host); ok {
+				hostKind = t.Kind()
+			}
+			id = tagSuffix(hostKind, host) + AttachmentSeparator + tagSuffix(FilesystemTagKind, filesystem)
+		}
+	case CloudCredentialTagKind:
+		id = strings.Replace(id, "/", cloudCredentialTagSeparator, 2)
+	case CloudRegionTagKind:
+		id = strings.Replace(id, "/", cloudRegionTagSeparator, 1)
+	case LeaseTagKind:
+		id = strings.Replace(id, "/", leaseTagSeparator, 1)
+	case RelationTagKind, RemoteRelationTagKind:
+		id = strings.Replace(id, ":", ".", 2)
+		id = strings.Replace(id, " ", "#", 1)
 	}
+	return id
 }
 
 func invalidTagError(tag, kind string) error {
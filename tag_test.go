@@ -29,6 +29,42 @@ var tagKindTests = []struct {
 	{tag: "unit", err: `"unit" is not a valid tag`},
 	{tag: "network", err: `"network" is not a valid tag`},
 	{tag: "network-42", kind: names.NetworkTagKind},
+	{tag: "volume-0-3", kind: names.VolumeTagKind},
+	{tag: "filesystem-0-3", kind: names.FilesystemTagKind},
+	{tag: "storage-data-0", kind: names.StorageTagKind},
+	{tag: "storageattachment-mysql-0:data-0", kind: names.StorageAttachmentTagKind},
+	{tag: "volumeattachment-0:0-3", kind: names.VolumeAttachmentTagKind},
+	{tag: "filesystemattachment-mysql-0:3", kind: names.FilesystemAttachmentTagKind},
+	{tag: "space-dmz", kind: names.SpaceTagKind},
+	{tag: "subnet-10.0.0.0/24", kind: names.SubnetTagKind},
+	{tag: "ipaddress-10.0.0.1", kind: names.IPAddressTagKind},
+	{tag: "model-42", kind: names.ModelTagKind},
+	{tag: "controller-42", kind: names.ControllerTagKind},
+	{tag: "controlleragent-0", kind: names.ControllerAgentTagKind},
+	{tag: "cloud-aws", kind: names.CloudTagKind},
+	{tag: "cloudcred-aws_bob_default", kind: names.CloudCredentialTagKind},
+	{tag: "application-wordpress", kind: names.ApplicationTagKind},
+	{tag: "applicationoffer-mysql", kind: names.ApplicationOfferTagKind},
+	{tag: "remoteapplication-mysql", kind: names.RemoteApplicationTagKind},
+	{tag: "remoterelation-riak.ring", kind: names.RemoteRelationTagKind},
+	{tag: "operation-1", kind: names.OperationTagKind},
+	{tag: "secret-9m4e2mr0ui3e8a215n4g", kind: names.SecretTagKind},
+	{tag: "secretbackend-vault", kind: names.SecretBackendTagKind},
+	{tag: "charm-cs:mysql", kind: names.CharmTagKind},
+	{tag: "resource-wordpress/logo", kind: names.ResourceTagKind},
+	{tag: "metricbatch-42", kind: names.MetricBatchTagKind},
+	{tag: "instance-i-0abc123", kind: names.InstanceTagKind},
+	{tag: "zone-us-east-1a", kind: names.AvailabilityZoneTagKind},
+	{tag: "cloudregion-aws_us-east-1", kind: names.CloudRegionTagKind},
+	{tag: "image-ami-0abc123", kind: names.ImageTagKind},
+	{tag: "sshkey-SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8", kind: names.SSHKeyTagKind},
+	{tag: "certificate-a1", kind: names.CertificateTagKind},
+	{tag: "backup-20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479", kind: names.BackupTagKind},
+	{tag: "upgrade-f47ac10b-58cc-4372-a567-0e02b2c3d479", kind: names.UpgradeTagKind},
+	{tag: "migration-f47ac10b-58cc-4372-a567-0e02b2c3d479", kind: names.MigrationTagKind},
+	{tag: "lease-application-leadership_mysql", kind: names.LeaseTagKind},
+	{tag: "auditentry-42", kind: names.AuditEntryTagKind},
+	{tag: "token-dGhpcyBpcyBhIHRva2Vu", kind: names.TokenTagKind},
 	{tag: "action-service-foo/3" + names.ActionMarker + "0", kind: names.ActionTagKind},
 	{tag: "action-wordpress/42" + names.ActionMarker + "0", kind: names.ActionTagKind},
 }
@@ -153,20 +189,468 @@ var parseTagTests = []struct {
 	expectKind: names.ActionTagKind,
 	expectType: names.ActionTag{},
 	resultId:   "wordpress/0" + names.ActionMarker + "333",
+}, {
+	tag:        "volume-3",
+	expectKind: names.VolumeTagKind,
+	expectType: names.VolumeTag{},
+	resultId:   "3",
+}, {
+	tag:        "volume-0-lxc-1-3",
+	expectKind: names.VolumeTagKind,
+	expectType: names.VolumeTag{},
+	resultId:   "0/lxc/1/3",
+}, {
+	tag:        "volume-wordpress-site-0-3",
+	expectKind: names.VolumeTagKind,
+	expectType: names.VolumeTag{},
+	resultId:   "wordpress-site/0/3",
+}, {
+	tag:        "volume-03",
+	expectKind: names.VolumeTagKind,
+	expectType: names.VolumeTag{},
+	resultErr:  `"volume-03" is not a valid volume tag`,
+}, {
+	tag:        "filesystem-3",
+	expectKind: names.FilesystemTagKind,
+	expectType: names.FilesystemTag{},
+	resultId:   "3",
+}, {
+	tag:        "filesystem-mysql-0-3",
+	expectKind: names.FilesystemTagKind,
+	expectType: names.FilesystemTag{},
+	resultId:   "mysql/0/3",
+}, {
+	tag:        "filesystem-mysql-3",
+	expectKind: names.FilesystemTagKind,
+	expectType: names.FilesystemTag{},
+	resultErr:  `"filesystem-mysql-3" is not a valid filesystem tag`,
+}, {
+	tag:        "storage-data-0",
+	expectKind: names.StorageTagKind,
+	expectType: names.StorageTag{},
+	resultId:   "data/0",
+}, {
+	tag:        "storage-shared-fs-12",
+	expectKind: names.StorageTagKind,
+	expectType: names.StorageTag{},
+	resultId:   "shared-fs/12",
+}, {
+	tag:        "storage-data",
+	expectKind: names.StorageTagKind,
+	expectType: names.StorageTag{},
+	resultErr:  `"storage-data" is not a valid storage tag`,
+}, {
+	tag:        "storageattachment-mysql-0:data-0",
+	expectKind: names.StorageAttachmentTagKind,
+	expectType: names.StorageAttachmentTag{},
+	resultId:   "mysql/0:data/0",
+}, {
+	tag:        "storageattachment-wordpress-site-10:shared-fs-2",
+	expectKind: names.StorageAttachmentTagKind,
+	expectType: names.StorageAttachmentTag{},
+	resultId:   "wordpress-site/10:shared-fs/2",
+}, {
+	tag:        "storageattachment-mysql-0",
+	expectKind: names.StorageAttachmentTagKind,
+	expectType: names.StorageAttachmentTag{},
+	resultErr:  `"storageattachment-mysql-0" is not a valid storageattachment tag`,
+}, {
+	tag:        "storageattachment-data-0:mysql",
+	expectKind: names.StorageAttachmentTagKind,
+	expectType: names.StorageAttachmentTag{},
+	resultErr:  `"storageattachment-data-0:mysql" is not a valid storageattachment tag`,
+}, {
+	tag:        "volumeattachment-0:3",
+	expectKind: names.VolumeAttachmentTagKind,
+	expectType: names.VolumeAttachmentTag{},
+	resultId:   "0:3",
+}, {
+	tag:        "volumeattachment-0-lxc-1:0-lxc-1-3",
+	expectKind: names.VolumeAttachmentTagKind,
+	expectType: names.VolumeAttachmentTag{},
+	resultId:   "0/lxc/1:0/lxc/1/3",
+}, {
+	tag:        "volumeattachment-0:mysql-0-3",
+	expectKind: names.VolumeAttachmentTagKind,
+	expectType: names.VolumeAttachmentTag{},
+	resultId:   "0:mysql/0/3",
+}, {
+	tag:        "volumeattachment-mysql-0:3",
+	expectKind: names.VolumeAttachmentTagKind,
+	expectType: names.VolumeAttachmentTag{},
+	resultErr:  `"volumeattachment-mysql-0:3" is not a valid volumeattachment tag`,
+}, {
+	tag:        "filesystemattachment-0-lxc-1:0-lxc-1-3",
+	expectKind: names.FilesystemAttachmentTagKind,
+	expectType: names.FilesystemAttachmentTag{},
+	resultId:   "0/lxc/1:0/lxc/1/3",
+}, {
+	tag:        "filesystemattachment-my-sql-0:my-sql-0-3",
+	expectKind: names.FilesystemAttachmentTagKind,
+	expectType: names.FilesystemAttachmentTag{},
+	resultId:   "my-sql/0:my-sql/0/3",
+}, {
+	tag:        "filesystemattachment-mysql:3",
+	expectKind: names.FilesystemAttachmentTagKind,
+	expectType: names.FilesystemAttachmentTag{},
+	resultErr:  `"filesystemattachment-mysql:3" is not a valid filesystemattachment tag`,
+}, {
+	tag:        "space-db-tier2",
+	expectKind: names.SpaceTagKind,
+	expectType: names.SpaceTag{},
+	resultId:   "db-tier2",
+}, {
+	tag:        "space-2db",
+	expectKind: names.SpaceTagKind,
+	expectType: names.SpaceTag{},
+	resultErr:  `"space-2db" is not a valid space tag`,
+}, {
+	tag:        "subnet-10.0.0.0/24",
+	expectKind: names.SubnetTagKind,
+	expectType: names.SubnetTag{},
+	resultId:   "10.0.0.0/24",
+}, {
+	tag:        "subnet-2001:db8::/32",
+	expectKind: names.SubnetTagKind,
+	expectType: names.SubnetTag{},
+	resultId:   "2001:db8::/32",
+}, {
+	tag:        "subnet-10.0.0.1/24",
+	expectKind: names.SubnetTagKind,
+	expectType: names.SubnetTag{},
+	resultErr:  `"subnet-10.0.0.1/24" is not a valid subnet tag`,
+}, {
+	tag:        "ipaddress-10.0.0.1",
+	expectKind: names.IPAddressTagKind,
+	expectType: names.IPAddressTag{},
+	resultId:   "10.0.0.1",
+}, {
+	tag:        "ipaddress-2001:db8::1",
+	expectKind: names.IPAddressTagKind,
+	expectType: names.IPAddressTag{},
+	resultId:   "2001:db8::1",
+}, {
+	tag:        "ipaddress-2001:db8:0::1",
+	expectKind: names.IPAddressTagKind,
+	expectType: names.IPAddressTag{},
+	resultErr:  `"ipaddress-2001:db8:0::1" is not a valid ipaddress tag`,
+}, {
+	tag:        "model-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expectKind: names.ModelTagKind,
+	expectType: names.ModelTag{},
+	resultId:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+}, {
+	tag:        "model-/",
+	expectKind: names.ModelTagKind,
+	expectType: names.ModelTag{},
+	resultErr:  `"model-/" is not a valid model tag`,
+}, {
+	tag:        "controller-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expectKind: names.ControllerTagKind,
+	expectType: names.ControllerTag{},
+	resultId:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+}, {
+	tag:        "controller-/",
+	expectKind: names.ControllerTagKind,
+	expectType: names.ControllerTag{},
+	resultErr:  `"controller-/" is not a valid controller tag`,
+}, {
+	tag:        "controlleragent-0",
+	expectKind: names.ControllerAgentTagKind,
+	expectType: names.ControllerAgentTag{},
+	resultId:   "0",
+}, {
+	tag:        "controlleragent-0-lxc-1",
+	expectKind: names.ControllerAgentTagKind,
+	expectType: names.ControllerAgentTag{},
+	resultErr:  `"controlleragent-0-lxc-1" is not a valid controlleragent tag`,
+}, {
+	tag:        "cloud-aws",
+	expectKind: names.CloudTagKind,
+	expectType: names.CloudTag{},
+	resultId:   "aws",
+}, {
+	tag:        "cloud-aws_china",
+	expectKind: names.CloudTagKind,
+	expectType: names.CloudTag{},
+	resultErr:  `"cloud-aws_china" is not a valid cloud tag`,
+}, {
+	tag:        "cloudcred-aws_bob@local_default",
+	expectKind: names.CloudCredentialTagKind,
+	expectType: names.CloudCredentialTag{},
+	resultId:   "aws/bob@local/default",
+}, {
+	tag:        "cloudcred-aws-bob-default",
+	expectKind: names.CloudCredentialTagKind,
+	expectType: names.CloudCredentialTag{},
+	resultErr:  `"cloudcred-aws-bob-default" is not a valid cloudcred tag`,
+}, {
+	tag:        "application-wordpress",
+	expectKind: names.ApplicationTagKind,
+	expectType: names.ApplicationTag{},
+	resultId:   "wordpress",
+}, {
+	tag:        "application-wordpress-0",
+	expectKind: names.ApplicationTagKind,
+	expectType: names.ApplicationTag{},
+	resultErr:  `"application-wordpress-0" is not a valid application tag`,
+}, {
+	tag:        "applicationoffer-bob/prod.mysql",
+	expectKind: names.ApplicationOfferTagKind,
+	expectType: names.ApplicationOfferTag{},
+	resultId:   "bob/prod.mysql",
+}, {
+	tag:        "applicationoffer-mysql-0",
+	expectKind: names.ApplicationOfferTagKind,
+	expectType: names.ApplicationOfferTag{},
+	resultErr:  `"applicationoffer-mysql-0" is not a valid applicationoffer tag`,
+}, {
+	tag:        "remoteapplication-mysql",
+	expectKind: names.RemoteApplicationTagKind,
+	expectType: names.RemoteApplicationTag{},
+	resultId:   "mysql",
+}, {
+	tag:        "remoteapplication-mysql-0",
+	expectKind: names.RemoteApplicationTagKind,
+	expectType: names.RemoteApplicationTag{},
+	resultErr:  `"remoteapplication-mysql-0" is not a valid remoteapplication tag`,
+}, {
+	tag:        "remoterelation-wordpress.db#mysql.server",
+	expectKind: names.RemoteRelationTagKind,
+	expectType: names.RemoteRelationTag{},
+	resultId:   "wordpress:db mysql:server",
+}, {
+	tag:        "remoterelation-wordpress",
+	expectKind: names.RemoteRelationTagKind,
+	expectType: names.RemoteRelationTag{},
+	resultErr:  `"remoterelation-wordpress" is not a valid remoterelation tag`,
+}, {
+	tag:        "operation-1",
+	expectKind: names.OperationTagKind,
+	expectType: names.OperationTag{},
+	resultId:   "1",
+}, {
+	tag:        "operation-01",
+	expectKind: names.OperationTagKind,
+	expectType: names.OperationTag{},
+	resultErr:  `"operation-01" is not a valid operation tag`,
+}, {
+	tag:        "secret-9m4e2mr0ui3e8a215n4g",
+	expectKind: names.SecretTagKind,
+	expectType: names.SecretTag{},
+	resultId:   "9m4e2mr0ui3e8a215n4g",
+}, {
+	tag:        "secret-9m4e2mr0ui3e8a215n4w",
+	expectKind: names.SecretTagKind,
+	expectType: names.SecretTag{},
+	resultErr:  `"secret-9m4e2mr0ui3e8a215n4w" is not a valid secret tag`,
+}, {
+	tag:        "secretbackend-vault",
+	expectKind: names.SecretBackendTagKind,
+	expectType: names.SecretBackendTag{},
+	resultId:   "vault",
+}, {
+	tag:        "secretbackend-vault/1",
+	expectKind: names.SecretBackendTagKind,
+	expectType: names.SecretBackendTag{},
+	resultErr:  `"secretbackend-vault/1" is not a valid secretbackend tag`,
+}, {
+	tag:        "charm-cs:trusty/mysql-2",
+	expectKind: names.CharmTagKind,
+	expectType: names.CharmTag{},
+	resultId:   "cs:trusty/mysql-2",
+}, {
+	tag:        "charm-mysql",
+	expectKind: names.CharmTagKind,
+	expectType: names.CharmTag{},
+	resultErr:  `"charm-mysql" is not a valid charm tag`,
+}, {
+	tag:        "resource-wordpress/logo",
+	expectKind: names.ResourceTagKind,
+	expectType: names.ResourceTag{},
+	resultId:   "wordpress/logo",
+}, {
+	tag:        "resource-wordpress-logo",
+	expectKind: names.ResourceTagKind,
+	expectType: names.ResourceTag{},
+	resultErr:  `"resource-wordpress-logo" is not a valid resource tag`,
+}, {
+	tag:        "metricbatch-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expectKind: names.MetricBatchTagKind,
+	expectType: names.MetricBatchTag{},
+	resultId:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+}, {
+	tag:        "metricbatch-42",
+	expectKind: names.MetricBatchTagKind,
+	expectType: names.MetricBatchTag{},
+	resultErr:  `"metricbatch-42" is not a valid metricbatch tag`,
+}, {
+	tag:        "instance-i-0abc123",
+	expectKind: names.InstanceTagKind,
+	expectType: names.InstanceTag{},
+	resultId:   "i-0abc123",
+}, {
+	tag:        "instance-",
+	expectKind: names.InstanceTagKind,
+	expectType: names.InstanceTag{},
+	resultErr:  `"instance-" is not a valid instance tag`,
+}, {
+	tag:        "zone-us-east-1a",
+	expectKind: names.AvailabilityZoneTagKind,
+	expectType: names.AvailabilityZoneTag{},
+	resultId:   "us-east-1a",
+}, {
+	tag:        "zone-us--east",
+	expectKind: names.AvailabilityZoneTagKind,
+	expectType: names.AvailabilityZoneTag{},
+	resultErr:  `"zone-us--east" is not a valid zone tag`,
+}, {
+	tag:        "cloudregion-aws_us-east-1",
+	expectKind: names.CloudRegionTagKind,
+	expectType: names.CloudRegionTag{},
+	resultId:   "aws/us-east-1",
+}, {
+	tag:        "cloudregion-aws",
+	expectKind: names.CloudRegionTagKind,
+	expectType: names.CloudRegionTag{},
+	resultErr:  `"cloudregion-aws" is not a valid cloudregion tag`,
+}, {
+	tag:        "image-ami-0abc123",
+	expectKind: names.ImageTagKind,
+	expectType: names.ImageTag{},
+	resultId:   "ami-0abc123",
+}, {
+	tag:        "image-",
+	expectKind: names.ImageTagKind,
+	expectType: names.ImageTag{},
+	resultErr:  `"image-" is not a valid image tag`,
+}, {
+	tag:        "sshkey-16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48",
+	expectKind: names.SSHKeyTagKind,
+	expectType: names.SSHKeyTag{},
+	resultId:   "16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48",
+}, {
+	tag:        "sshkey-16:27",
+	expectKind: names.SSHKeyTagKind,
+	expectType: names.SSHKeyTag{},
+	resultErr:  `"sshkey-16:27" is not a valid sshkey tag`,
+}, {
+	tag:        "certificate-a1",
+	expectKind: names.CertificateTagKind,
+	expectType: names.CertificateTag{},
+	resultId:   "a1",
+}, {
+	tag:        "certificate-00a1",
+	expectKind: names.CertificateTagKind,
+	expectType: names.CertificateTag{},
+	resultErr:  `"certificate-00a1" is not a valid certificate tag`,
+}, {
+	tag:        "backup-20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expectKind: names.BackupTagKind,
+	expectType: names.BackupTag{},
+	resultId:   "20240101-123456.f47ac10b-58cc-4372-a567-0e02b2c3d479",
+}, {
+	tag:        "backup-20240101-123456",
+	expectKind: names.BackupTagKind,
+	expectType: names.BackupTag{},
+	resultErr:  `"backup-20240101-123456" is not a valid backup tag`,
+}, {
+	tag:        "upgrade-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expectKind: names.UpgradeTagKind,
+	expectType: names.UpgradeTag{},
+	resultId:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+}, {
+	tag:        "upgrade-f47ac10b",
+	expectKind: names.UpgradeTagKind,
+	expectType: names.UpgradeTag{},
+	resultErr:  `"upgrade-f47ac10b" is not a valid upgrade tag`,
+}, {
+	tag:        "migration-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expectKind: names.MigrationTagKind,
+	expectType: names.MigrationTag{},
+	resultId:   "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+}, {
+	tag:        "migration-f47ac10b",
+	expectKind: names.MigrationTagKind,
+	expectType: names.MigrationTag{},
+	resultErr:  `"migration-f47ac10b" is not a valid migration tag`,
+}, {
+	tag:        "lease-application-leadership_mysql",
+	expectKind: names.LeaseTagKind,
+	expectType: names.LeaseTag{},
+	resultId:   "application-leadership/mysql",
+}, {
+	tag:        "lease-application-leadership",
+	expectKind: names.LeaseTagKind,
+	expectType: names.LeaseTag{},
+	resultErr:  `"lease-application-leadership" is not a valid lease tag`,
+}, {
+	tag:        "auditentry-42",
+	expectKind: names.AuditEntryTagKind,
+	expectType: names.AuditEntryTag{},
+	resultId:   "42",
+}, {
+	tag:        "auditentry-042",
+	expectKind: names.AuditEntryTagKind,
+	expectType: names.AuditEntryTag{},
+	resultErr:  `"auditentry-042" is not a valid auditentry tag`,
+}, {
+	tag:        "token-dGhpcyBpcyBhIHRva2Vu",
+	expectKind: names.TokenTagKind,
+	expectType: names.TokenTag{},
+	resultId:   "dGhpcyBpcyBhIHRva2Vu",
+}, {
+	tag:        "token-abc",
+	expectKind: names.TokenTagKind,
+	expectType: names.TokenTag{},
+	resultErr:  `"token-abc" is not a valid token tag`,
 }, {
 	tag:       "foo",
 	resultErr: `"foo" is not a valid tag`,
 }}
 
 var makeTag = map[string]func(string) names.Tag{
-	names.MachineTagKind:  func(tag string) names.Tag { return names.NewMachineTag(tag) },
-	names.UnitTagKind:     func(tag string) names.Tag { return names.NewUnitTag(tag) },
-	names.ServiceTagKind:  func(tag string) names.Tag { return names.NewServiceTag(tag) },
-	names.RelationTagKind: func(tag string) names.Tag { return names.NewRelationTag(tag) },
-	names.EnvironTagKind:  func(tag string) names.Tag { return names.NewEnvironTag(tag) },
-	names.UserTagKind:     func(tag string) names.Tag { return names.NewUserTag(tag) },
-	names.NetworkTagKind:  func(tag string) names.Tag { return names.NewNetworkTag(tag) },
-	names.ActionTagKind:   func(tag string) names.Tag { return names.NewActionTag(tag) },
+	names.MachineTagKind:           func(tag string) names.Tag { return names.NewMachineTag(tag) },
+	names.UnitTagKind:              func(tag string) names.Tag { return names.NewUnitTag(tag) },
+	names.ServiceTagKind:           func(tag string) names.Tag { return names.NewServiceTag(tag) },
+	names.RelationTagKind:          func(tag string) names.Tag { return names.NewRelationTag(tag) },
+	names.EnvironTagKind:           func(tag string) names.Tag { return names.NewEnvironTag(tag) },
+	names.UserTagKind:              func(tag string) names.Tag { return names.NewUserTag(tag) },
+	names.NetworkTagKind:           func(tag string) names.Tag { return names.NewNetworkTag(tag) },
+	names.ActionTagKind:            func(tag string) names.Tag { return names.NewActionTag(tag) },
+	names.VolumeTagKind:            func(tag string) names.Tag { return names.NewVolumeTag(tag) },
+	names.FilesystemTagKind:        func(tag string) names.Tag { return names.NewFilesystemTag(tag) },
+	names.StorageTagKind:           func(tag string) names.Tag { return names.NewStorageTag(tag) },
+	names.SpaceTagKind:             func(tag string) names.Tag { return names.NewSpaceTag(tag) },
+	names.SubnetTagKind:            func(tag string) names.Tag { return names.NewSubnetTag(tag) },
+	names.IPAddressTagKind:         func(tag string) names.Tag { return names.NewIPAddressTag(tag) },
+	names.ModelTagKind:             func(tag string) names.Tag { return names.NewModelTag(tag) },
+	names.ControllerTagKind:        func(tag string) names.Tag { return names.NewControllerTag(tag) },
+	names.ControllerAgentTagKind:   func(tag string) names.Tag { return names.NewControllerAgentTag(tag) },
+	names.CloudTagKind:             func(tag string) names.Tag { return names.NewCloudTag(tag) },
+	names.CloudCredentialTagKind:   func(tag string) names.Tag { return names.NewCloudCredentialTag(tag) },
+	names.ApplicationTagKind:       func(tag string) names.Tag { return names.NewApplicationTag(tag) },
+	names.ApplicationOfferTagKind:  func(tag string) names.Tag { return names.NewApplicationOfferTag(tag) },
+	names.RemoteApplicationTagKind: func(tag string) names.Tag { return names.NewRemoteApplicationTag(tag) },
+	names.RemoteRelationTagKind:    func(tag string) names.Tag { return names.NewRemoteRelationTag(tag) },
+	names.OperationTagKind:         func(tag string) names.Tag { return names.NewOperationTag(tag) },
+	names.SecretTagKind:            func(tag string) names.Tag { return names.NewSecretTag(tag) },
+	names.SecretBackendTagKind:     func(tag string) names.Tag { return names.NewSecretBackendTag(tag) },
+	names.CharmTagKind:             func(tag string) names.Tag { return names.NewCharmTag(tag) },
+	names.ResourceTagKind:          func(tag string) names.Tag { return names.NewResourceTag(tag) },
+	names.MetricBatchTagKind:       func(tag string) names.Tag { return names.NewMetricBatchTag(tag) },
+	names.InstanceTagKind:          func(tag string) names.Tag { return names.NewInstanceTag(tag) },
+	names.AvailabilityZoneTagKind:  func(tag string) names.Tag { return names.NewAvailabilityZoneTag(tag) },
+	names.CloudRegionTagKind:       func(tag string) names.Tag { return names.NewCloudRegionTag(tag) },
+	names.ImageTagKind:             func(tag string) names.Tag { return names.NewImageTag(tag) },
+	names.SSHKeyTagKind:            func(tag string) names.Tag { return names.NewSSHKeyTag(tag) },
+	names.CertificateTagKind:       func(tag string) names.Tag { return names.NewCertificateTag(tag) },
+	names.BackupTagKind:            func(tag string) names.Tag { return names.NewBackupTag(tag) },
+	names.UpgradeTagKind:           func(tag string) names.Tag { return names.NewUpgradeTag(tag) },
+	names.MigrationTagKind:         func(tag string) names.Tag { return names.NewMigrationTag(tag) },
+	names.LeaseTagKind:             func(tag string) names.Tag { return names.NewLeaseTag(tag) },
+	names.AuditEntryTagKind:        func(tag string) names.Tag { return names.NewAuditEntryTag(tag) },
+	names.TokenTagKind:             func(tag string) names.Tag { return names.NewTokenTag(tag) },
 }
 
 func (*tagSuite) TestParseTag(c *gc.C) {
@@ -212,3 +696,49 @@ func (*tagSuite) TestParseTag(c *gc.C) {
 		}
 	}
 }
+
+func (*tagSuite) TestTagFromKindId(c *gc.C) {
+	for i, test := range parseTagTests {
+		if test.resultErr != "" {
+			continue
+		}
+		c.Logf("test %d: %s %q", i, test.expectKind, test.resultId)
+		tag, err := names.TagFromKindId(test.expectKind, test.resultId)
+		c.Assert(err, gc.IsNil)
+		c.Check(tag.String(), gc.Equals, test.tag)
+	}
+	_, err := names.TagFromKindId("foo", "bar")
+	c.Check(err, gc.ErrorMatches, `"foo" is not a valid tag kind`)
+	_, err = names.TagFromKindId(names.UnitTagKind, "mysql-0")
+	c.Check(err, gc.ErrorMatches, `"mysql-0" is not a valid unit id`)
+	_, err = names.TagFromKindId(names.MachineTagKind, "0-lxc-1")
+	c.Check(err, gc.ErrorMatches, `"0-lxc-1" is not a valid machine id`)
+}
+
+type tagger string
+
+func (t tagger) Tag() string { return string(t) }
+
+func (*tagSuite) TestTagFromTagger(c *gc.C) {
+	tag, err := names.TagFromTagger(tagger("unit-mysql-0"))
+	c.Assert(err, gc.IsNil)
+	c.Check(tag, gc.Equals, names.NewUnitTag("mysql/0"))
+
+	_, err = names.TagFromTagger(tagger("unit-mysql"))
+	c.Check(err, gc.ErrorMatches, `"unit-mysql" is not a valid unit tag`)
+	_, err = names.TagFromTagger(nil)
+	c.Check(err, gc.ErrorMatches, `no entity to take tag from`)
+}
+
+func (*tagSuite) TestAppendKindId(c *gc.C) {
+	for i, test := range parseTagTests {
+		if test.resultErr != "" {
+			continue
+		}
+		c.Logf("test %d: %s %q", i, test.expectKind, test.resultId)
+		buf := names.AppendKindId([]byte("x:"), test.expectKind, test.resultId)
+		c.Check(string(buf), gc.Equals, "x:"+test.tag)
+	}
+	buf := names.AppendKindId(nil, names.MachineTagKind, "0"+names.ContainerSeparator+"lxc"+names.ContainerSeparator+"1")
+	c.Check(string(buf), gc.Equals, "machine"+names.KindSeparator+"0-lxc-1")
+}
@@ -0,0 +1,26 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+// TagDoc holds a tag as separate kind and id fields, for wire formats
+// and database documents that store them separately rather than as the
+// tag's string representation.
+type TagDoc struct {
+	Kind string `json:"kind" yaml:"kind" bson:"kind"`
+	Id   string `json:"id" yaml:"id" bson:"id"`
+}
+
+// ToDoc returns the document form of t. A nil tag yields the zero
+// TagDoc.
+func ToDoc(t Tag) TagDoc {
+	if t == nil {
+		return TagDoc{}
+	}
+	return TagDoc{Kind: t.Kind(), Id: t.Id()}
+}
+
+// FromDoc returns the tag held in doc, validating its kind and id.
+func FromDoc(doc TagDoc) (Tag, error) {
+	return TagFromKindId(doc.Kind, doc.Id)
+}
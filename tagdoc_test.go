@@ -0,0 +1,64 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"encoding/json"
+
+	gc "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/juju/names"
+)
+
+type tagDocSuite struct{}
+
+var _ = gc.Suite(&tagDocSuite{})
+
+func (s *tagDocSuite) TestRoundTrip(c *gc.C) {
+	for i, test := range encodingTags {
+		if _, ok := test.tag.(names.AgentTag); ok {
+			continue
+		}
+		c.Logf("test %d: %s", i, test.tag)
+		doc := names.ToDoc(test.tag)
+		c.Check(doc, gc.Equals, names.TagDoc{Kind: test.tag.Kind(), Id: test.tag.Id()})
+		t, err := names.FromDoc(doc)
+		c.Assert(err, gc.IsNil)
+		c.Check(t, gc.Equals, test.tag)
+	}
+}
+
+func (s *tagDocSuite) TestToDocNil(c *gc.C) {
+	c.Check(names.ToDoc(nil), gc.Equals, names.TagDoc{})
+}
+
+func (s *tagDocSuite) TestFromDocErrors(c *gc.C) {
+	for i, test := range []struct {
+		doc names.TagDoc
+		err string
+	}{
+		{names.TagDoc{}, `"" is not a valid tag kind`},
+		{names.TagDoc{Kind: "foo", Id: "bar"}, `"foo" is not a valid tag kind`},
+		{names.TagDoc{Kind: "unit", Id: "mysql"}, `"mysql" is not a valid unit id`},
+		{names.TagDoc{Kind: "machine", Id: "0-lxc-1"}, `"0-lxc-1" is not a valid machine id`},
+	} {
+		c.Logf("test %d: %+v", i, test.doc)
+		_, err := names.FromDoc(test.doc)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *tagDocSuite) TestFieldNames(c *gc.C) {
+	doc := names.ToDoc(names.NewUnitTag("mysql/0"))
+	data, err := json.Marshal(doc)
+	c.Assert(err, gc.IsNil)
+	c.Check(string(data), gc.Equals, `{"kind":"unit","id":"mysql/0"}`)
+
+	data, err = bson.Marshal(doc)
+	c.Assert(err, gc.IsNil)
+	var m bson.M
+	c.Assert(bson.Unmarshal(data, &m), gc.IsNil)
+	c.Check(m, gc.DeepEquals, bson.M{"kind": "unit", "id": "mysql/0"})
+}
@@ -0,0 +1,86 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+//go:build !tinygo && !names_noregexp
+
+package names
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// TemplateFuncs returns functions for formatting tags in text and
+// HTML templates. Each function accepts either a Tag or the string
+// representation of a tag:
+//
+//	tag          the tag's string representation, e.g. "unit-mysql-0"
+//	tagKind      the tag's kind, e.g. "unit"
+//	tagID        the tag's id, e.g. "mysql/0"
+//	unitApp      the application of a unit tag (or unit name), e.g. "mysql"
+//	readable     the tag's kind and id, e.g. "unit mysql/0"
+//
+// unitService is an alias of unitApp, kept for existing templates. A
+// string that is not a valid tag causes template execution to fail.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"tag": func(v interface{}) (string, error) {
+			t, err := templateTag(v)
+			if err != nil {
+				return "", err
+			}
+			return t.String(), nil
+		},
+		"tagKind": func(v interface{}) (string, error) {
+			t, err := templateTag(v)
+			if err != nil {
+				return "", err
+			}
+			return t.Kind(), nil
+		},
+		"tagID": func(v interface{}) (string, error) {
+			t, err := templateTag(v)
+			if err != nil {
+				return "", err
+			}
+			return t.Id(), nil
+		},
+		"unitApp":     templateUnitApp,
+		"unitService": templateUnitApp,
+		"readable": func(v interface{}) (string, error) {
+			t, err := templateTag(v)
+			if err != nil {
+				return "", err
+			}
+			return t.Kind() + " " + t.Id(), nil
+		},
+	}
+}
+
+// templateUnitApp returns the name of the application of the unit
+// represented by a template argument, a unit tag or unit name.
+func templateUnitApp(v interface{}) (string, error) {
+	if name, ok := v.(string); ok && IsValidUnit(name) {
+		return UnitService(name), nil
+	}
+	t, err := templateTag(v)
+	if err != nil {
+		return "", err
+	}
+	ut, ok := t.(UnitTag)
+	if !ok {
+		return "", fmt.Errorf("%q is not a unit tag", t.String())
+	}
+	return UnitService(ut.Id()), nil
+}
+
+// templateTag returns the tag represented by a template argument.
+func templateTag(v interface{}) (Tag, error) {
+	switch v := v.(type) {
+	case Tag:
+		return v, nil
+	case string:
+		return ParseTag(v)
+	}
+	return nil, fmt.Errorf("cannot use %T as a tag", v)
+}
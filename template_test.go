@@ -0,0 +1,86 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+//go:build !tinygo && !names_noregexp
+
+package names_test
+
+import (
+	"bytes"
+	"text/template"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type templateSuite struct{}
+
+var _ = gc.Suite(&templateSuite{})
+
+var templateTests = []struct {
+	text   string
+	data   interface{}
+	expect string
+	err    string
+}{{
+	text:   `{{tag .}}`,
+	data:   names.NewUnitTag("mysql/0"),
+	expect: "unit-mysql-0",
+}, {
+	text:   `{{tagKind .}} {{tagID .}}`,
+	data:   "machine-0-lxc-1",
+	expect: "machine 0/lxc/1",
+}, {
+	text:   `{{unitApp .}}`,
+	data:   names.NewUnitTag("rabbitmq-server/3"),
+	expect: "rabbitmq-server",
+}, {
+	text:   `{{unitApp .}}`,
+	data:   "mysql/0",
+	expect: "mysql",
+}, {
+	text:   `{{unitApp .}}`,
+	data:   "unit-mysql-0",
+	expect: "mysql",
+}, {
+	// unitService is an alias of unitApp.
+	text:   `{{unitService .}}`,
+	data:   "unit-mysql-0",
+	expect: "mysql",
+}, {
+	text:   `{{readable .}}`,
+	data:   names.NewUserTag("bob@local"),
+	expect: "user bob@local",
+}, {
+	text:   `{{range .}}{{readable .}}; {{end}}`,
+	data:   []names.Tag{names.NewServiceTag("wordpress"), names.NewMachineTag("3")},
+	expect: "service wordpress; machine 3; ",
+}, {
+	text: `{{tag .}}`,
+	data: "unit-mysql",
+	err:  `.*"unit-mysql" is not a valid unit tag`,
+}, {
+	text: `{{tagKind .}}`,
+	data: 42,
+	err:  `.*cannot use int as a tag`,
+}, {
+	text: `{{unitApp .}}`,
+	data: "service-mysql",
+	err:  `.*"service-mysql" is not a unit tag`,
+}}
+
+func (s *templateSuite) TestTemplateFuncs(c *gc.C) {
+	for i, test := range templateTests {
+		c.Logf("test %d: %s", i, test.text)
+		t := template.Must(template.New("").Funcs(names.TemplateFuncs()).Parse(test.text))
+		var buf bytes.Buffer
+		err := t.Execute(&buf, test.data)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Check(err, gc.IsNil)
+		c.Check(buf.String(), gc.Equals, test.expect)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const TokenTagKind = "token"
+
+// The minimum and maximum lengths of a token id. Token ids are random,
+// so short ones are rejected as too easily guessed.
+const (
+	MinTokenIdLength = 16
+	MaxTokenIdLength = 256
+)
+
+// IsValidToken returns whether id is a valid token id: between
+// MinTokenIdLength and MaxTokenIdLength characters of the URL-safe
+// base64 alphabet of RFC 4648, letters, digits, "-" and "_", without
+// padding.
+func IsValidToken(id string) bool {
+	if len(id) < MinTokenIdLength || len(id) > MaxTokenIdLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// TokenTag represents a token, such as one exchanged for a macaroon
+// when authenticating entities across models. The token id is opaque.
+type TokenTag struct {
+	id string
+}
+
+func (t TokenTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t TokenTag) Kind() string   { return TokenTagKind }
+func (t TokenTag) Id() string     { return t.id }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t TokenTag) AppendString(dst []byte) []byte {
+	return append(append(dst, TokenTagKind+KindSeparator...), t.id...)
+}
+
+// NewTokenTag returns the tag of the token with the given id. It will
+// panic if the given id is not valid.
+func NewTokenTag(id string) TokenTag {
+	if !IsValidToken(id) {
+		panic(fmt.Sprintf("%q is not a valid token id", id))
+	}
+	return TokenTag{id: id}
+}
+
+// ParseTokenTag parses a token tag string.
+func ParseTokenTag(tokenTag string) (TokenTag, error) {
+	tag, err := ParseTag(tokenTag)
+	if err != nil {
+		return TokenTag{}, err
+	}
+	tt, ok := tag.(TokenTag)
+	if !ok {
+		return TokenTag{}, invalidTagError(tokenTag, TokenTagKind)
+	}
+	return tt, nil
+}
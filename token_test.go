@@ -0,0 +1,88 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type tokenSuite struct{}
+
+var _ = gc.Suite(&tokenSuite{})
+
+var tokenIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "dGhpcyBpcyBhIHRva2Vu", valid: true},
+	{id: "Ab0-_Ab0-_Ab0-_A", valid: true},
+	{id: strings.Repeat("a", names.MinTokenIdLength), valid: true},
+	{id: strings.Repeat("a", names.MaxTokenIdLength), valid: true},
+	{id: ""},
+	{id: strings.Repeat("a", names.MinTokenIdLength-1)},
+	{id: strings.Repeat("a", names.MaxTokenIdLength+1)},
+	{id: "dGhpcyBpcyBhIHRva2Vu="},
+	{id: "dGhpcyBpcyBh+HRva2Vu"},
+	{id: "dGhpcyBpcyBh/HRva2Vu"},
+	{id: "dGhpcyBpcyBh HRva2Vu"},
+	{id: "dGhpcyBpcyBh.HRva2Vu"},
+}
+
+func (s *tokenSuite) TestTokenTag(c *gc.C) {
+	for i, test := range tokenIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidToken(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid token id", test.id)
+			testTag := func() { names.NewTokenTag(test.id) }
+			c.Check(testTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewTokenTag(test.id)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		parsed, err := names.ParseTokenTag(tag.String())
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseTokenTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "token-dGhpcyBpcyBhIHRva2Vu",
+	expected: names.NewTokenTag("dGhpcyBpcyBhIHRva2Vu"),
+}, {
+	tag: "token-abc",
+	err: names.InvalidTagError("token-abc", names.TokenTagKind),
+}, {
+	tag: "token-",
+	err: names.InvalidTagError("token-", names.TokenTagKind),
+}, {
+	tag: "image-dGhpcyBpcyBhIHRva2Vu",
+	err: names.InvalidTagError("image-dGhpcyBpcyBhIHRva2Vu", names.TokenTagKind),
+}}
+
+func (s *tokenSuite) TestParseTokenTag(c *gc.C) {
+	for i, t := range parseTokenTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseTokenTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -5,14 +5,11 @@ package names
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 )
 
 const UnitTagKind = "unit"
 
-var validUnit = regexp.MustCompile("^(" + ServiceSnippet + ")/" + NumberSnippet + "$")
-
 type UnitTag struct {
 	name string
 }
@@ -21,6 +18,12 @@ func (t UnitTag) String() string { return t.Kind() + "-" + t.name }
 func (t UnitTag) Kind() string   { return UnitTagKind }
 func (t UnitTag) Id() string     { return unitTagSuffixToId(t.name) }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t UnitTag) AppendString(dst []byte) []byte {
+	return append(append(dst, UnitTagKind+KindSeparator...), t.name...)
+}
+
 // NewUnitTag returns the tag for the unit with the given name.
 // It will panic if the given unit name is not valid.
 func NewUnitTag(unitName string) UnitTag {
@@ -46,17 +49,18 @@ func ParseUnitTag(unitTag string) (UnitTag, error) {
 
 // IsValidUnit returns whether name is a valid unit name.
 func IsValidUnit(name string) bool {
-	return validUnit.MatchString(name)
+	_, ok := matchUnit(name)
+	return ok
 }
 
 // UnitService returns the name of the service that the unit is
 // associated with. It panics if unitName is not a valid unit name.
 func UnitService(unitName string) string {
-	s := validUnit.FindStringSubmatch(unitName)
-	if s == nil {
+	service, ok := matchUnit(unitName)
+	if !ok {
 		panic(fmt.Sprintf("%q is not a valid unit name", unitName))
 	}
-	return s[1]
+	return service
 }
 
 func tagFromUnitName(unitName string) (UnitTag, bool) {
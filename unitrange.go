@@ -0,0 +1,97 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// UnitRange represents a span of consecutively numbered units of a
+// single service, such as mysql/0 to mysql/9.
+type UnitRange struct {
+	service     string
+	first, last int
+}
+
+// ParseUnitRange parses a unit range written as "mysql/0..mysql/9" or
+// "mysql/0-9". The first unit number must not be greater than the last,
+// and the range must not hold more units than an int can count.
+func ParseUnitRange(s string) (UnitRange, error) {
+	var first, last string
+	if i := strings.Index(s, ".."); i >= 0 {
+		first, last = s[:i], s[i+2:]
+	} else if i := strings.LastIndex(s, "-"); i > strings.LastIndex(s, "/") {
+		first, last = s[:i], s[:strings.LastIndex(s, "/")+1]+s[i+1:]
+	}
+	if !IsValidUnit(first) || !IsValidUnit(last) {
+		return UnitRange{}, fmt.Errorf("%q is not a valid unit range", s)
+	}
+	r := UnitRange{service: UnitService(first)}
+	var err1, err2 error
+	r.first, err1 = unitNumber(first)
+	r.last, err2 = unitNumber(last)
+	if err1 != nil || err2 != nil || UnitService(last) != r.service || r.first > r.last ||
+		r.last-r.first == math.MaxInt {
+		return UnitRange{}, fmt.Errorf("%q is not a valid unit range", s)
+	}
+	return r, nil
+}
+
+// unitNumber returns the number of the unit with the given valid name.
+func unitNumber(unitName string) (int, error) {
+	return strconv.Atoi(unitName[strings.LastIndex(unitName, "/")+1:])
+}
+
+// Service returns the name of the service the units belong to.
+func (r UnitRange) Service() string {
+	return r.service
+}
+
+// First returns the tag of the first unit in the range.
+func (r UnitRange) First() UnitTag {
+	return r.unit(r.first)
+}
+
+// Last returns the tag of the last unit in the range.
+func (r UnitRange) Last() UnitTag {
+	return r.unit(r.last)
+}
+
+// Len returns the number of units in the range.
+func (r UnitRange) Len() int {
+	return r.last - r.first + 1
+}
+
+// Contains returns whether the unit is in the range.
+func (r UnitRange) Contains(tag UnitTag) bool {
+	id := tag.Id()
+	if !IsValidUnit(id) || UnitService(id) != r.service {
+		return false
+	}
+	n, err := unitNumber(id)
+	return err == nil && n >= r.first && n <= r.last
+}
+
+// Units returns the tags of all the units in the range, in order.
+func (r UnitRange) Units() []UnitTag {
+	// The range may be too large to preallocate, so let append grow
+	// the slice.
+	var units []UnitTag
+	for i, n := 0, r.Len(); i < n; i++ {
+		units = append(units, r.unit(r.first+i))
+	}
+	return units
+}
+
+// String returns the range in the form "mysql/0..mysql/9".
+func (r UnitRange) String() string {
+	return r.First().Id() + ".." + r.Last().Id()
+}
+
+func (r UnitRange) unit(n int) UnitTag {
+	return NewUnitTag(r.service + "/" + strconv.Itoa(n))
+}
@@ -0,0 +1,113 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type unitRangeSuite struct{}
+
+var _ = gc.Suite(&unitRangeSuite{})
+
+var parseUnitRangeTests = []struct {
+	input       string
+	service     string
+	first, last string
+	len         int
+	err         string
+}{{
+	input:   "mysql/0..mysql/9",
+	service: "mysql",
+	first:   "mysql/0",
+	last:    "mysql/9",
+	len:     10,
+}, {
+	input:   "mysql/0-9",
+	service: "mysql",
+	first:   "mysql/0",
+	last:    "mysql/9",
+	len:     10,
+}, {
+	input:   "wordpress-site/3-3",
+	service: "wordpress-site",
+	first:   "wordpress-site/3",
+	last:    "wordpress-site/3",
+	len:     1,
+}, {
+	input:   "wordpress-site/12..wordpress-site/20",
+	service: "wordpress-site",
+	first:   "wordpress-site/12",
+	last:    "wordpress-site/20",
+	len:     9,
+}, {
+	input: "mysql/0",
+	err:   `"mysql/0" is not a valid unit range`,
+}, {
+	input: "mysql/9..mysql/0",
+	err:   `"mysql/9..mysql/0" is not a valid unit range`,
+}, {
+	input: "mysql/0..wordpress/3",
+	err:   `"mysql/0..wordpress/3" is not a valid unit range`,
+}, {
+	input: "mysql/0-09",
+	err:   `"mysql/0-09" is not a valid unit range`,
+}, {
+	input: "mysql/0..",
+	err:   `"mysql/0.." is not a valid unit range`,
+}, {
+	input: "mysql/0..mysql/9223372036854775807",
+	err:   `"mysql/0..mysql/9223372036854775807" is not a valid unit range`,
+}, {
+	input: "mysql/0-9223372036854775807",
+	err:   `"mysql/0-9223372036854775807" is not a valid unit range`,
+}, {
+	input: "mysql-0-9",
+	err:   `"mysql-0-9" is not a valid unit range`,
+}}
+
+func (s *unitRangeSuite) TestParseUnitRange(c *gc.C) {
+	for i, test := range parseUnitRangeTests {
+		c.Logf("test %d: %q", i, test.input)
+		r, err := names.ParseUnitRange(test.input)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Assert(err, gc.IsNil)
+		c.Check(r.Service(), gc.Equals, test.service)
+		c.Check(r.First(), gc.Equals, names.NewUnitTag(test.first))
+		c.Check(r.Last(), gc.Equals, names.NewUnitTag(test.last))
+		c.Check(r.Len(), gc.Equals, test.len)
+		c.Check(r.String(), gc.Equals, test.first+".."+test.last)
+	}
+}
+
+func (s *unitRangeSuite) TestContains(c *gc.C) {
+	r, err := names.ParseUnitRange("mysql/2-4")
+	c.Assert(err, gc.IsNil)
+	for unit, expect := range map[string]bool{
+		"mysql/1":    false,
+		"mysql/2":    true,
+		"mysql/4":    true,
+		"mysql/5":    false,
+		"mysql-a/3":  false,
+		"postgres/3": false,
+	} {
+		c.Check(r.Contains(names.NewUnitTag(unit)), gc.Equals, expect, gc.Commentf("%s", unit))
+	}
+	c.Check(r.Contains(names.UnitTag{}), gc.Equals, false)
+}
+
+func (s *unitRangeSuite) TestUnits(c *gc.C) {
+	r, err := names.ParseUnitRange("mysql/8..mysql/10")
+	c.Assert(err, gc.IsNil)
+	c.Check(r.Units(), gc.DeepEquals, []names.UnitTag{
+		names.NewUnitTag("mysql/8"),
+		names.NewUnitTag("mysql/9"),
+		names.NewUnitTag("mysql/10"),
+	})
+}
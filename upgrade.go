@@ -0,0 +1,54 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const UpgradeTagKind = "upgrade"
+
+// IsValidUpgrade returns whether id is a valid upgrade UUID.
+func IsValidUpgrade(id string) bool {
+	_, ok := parseUUID(id)
+	return ok
+}
+
+// UpgradeTag represents an upgrade of a controller or model that is in
+// progress, so that the workers coordinating it can refer to it.
+type UpgradeTag struct {
+	uuid string
+}
+
+func (t UpgradeTag) String() string { return t.Kind() + "-" + t.Id() }
+func (t UpgradeTag) Kind() string   { return UpgradeTagKind }
+func (t UpgradeTag) Id() string     { return t.uuid }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t UpgradeTag) AppendString(dst []byte) []byte {
+	return append(append(dst, UpgradeTagKind+KindSeparator...), t.uuid...)
+}
+
+// NewUpgradeTag returns the tag of the upgrade with the given UUID. It
+// will panic if the given UUID is not valid.
+func NewUpgradeTag(uuid string) UpgradeTag {
+	if !IsValidUpgrade(uuid) {
+		panic(fmt.Sprintf("%q is not a valid upgrade UUID", uuid))
+	}
+	return UpgradeTag{uuid: uuid}
+}
+
+// ParseUpgradeTag parses an upgrade tag string.
+func ParseUpgradeTag(upgradeTag string) (UpgradeTag, error) {
+	tag, err := ParseTag(upgradeTag)
+	if err != nil {
+		return UpgradeTag{}, err
+	}
+	ut, ok := tag.(UpgradeTag)
+	if !ok {
+		return UpgradeTag{}, invalidTagError(upgradeTag, UpgradeTagKind)
+	}
+	return ut, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type upgradeSuite struct{}
+
+var _ = gc.Suite(&upgradeSuite{})
+
+func (s *upgradeSuite) TestIsValidUpgrade(c *gc.C) {
+	c.Check(names.IsValidUpgrade("f47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, true)
+	c.Check(names.IsValidUpgrade("xf47ac10b-58cc-4372-a567-0e02b2c3d479"), gc.Equals, false)
+	c.Check(names.IsValidUpgrade("F47AC10B-58CC-4372-A567-0E02B2C3D479"), gc.Equals, false)
+	c.Check(names.IsValidUpgrade("f47ac10b"), gc.Equals, false)
+	c.Check(names.IsValidUpgrade(""), gc.Equals, false)
+	c.Check(func() { names.NewUpgradeTag("f47ac10b") }, gc.PanicMatches, `"f47ac10b" is not a valid upgrade UUID`)
+}
+
+var parseUpgradeTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "upgrade-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	expected: names.NewUpgradeTag("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+}, {
+	tag: "upgrade-f47ac10b",
+	err: names.InvalidTagError("upgrade-f47ac10b", names.UpgradeTagKind),
+}, {
+	tag: "upgrade",
+	err: names.InvalidTagError("upgrade", ""),
+}, {
+	tag: "metricbatch-f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	err: names.InvalidTagError("metricbatch-f47ac10b-58cc-4372-a567-0e02b2c3d479", names.UpgradeTagKind),
+}}
+
+func (s *upgradeSuite) TestParseUpgradeTag(c *gc.C) {
+	for i, t := range parseUpgradeTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseUpgradeTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
@@ -5,7 +5,7 @@ package names
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
 )
 
 const (
@@ -13,19 +13,130 @@ const (
 	LocalProvider = "local"
 )
 
-var validPart = "[a-zA-Z0-9][a-zA-Z0-9.-]*[a-zA-Z0-9]"
-
-var validName = regexp.MustCompile(fmt.Sprintf("^(?P<name>%s)(?:@(?P<provider>%s))?$", validPart, validPart))
-var validUserName = regexp.MustCompile("^" + validPart + "$")
-
 // IsValidUser returns whether id is a valid user id.
 func IsValidUser(name string) bool {
-	return validName.MatchString(name)
+	_, _, ok := matchUser(name)
+	return ok
 }
 
 // IsValidUserName returns whether the user's name is a valid.
 func IsValidUserName(name string) bool {
-	return validUserName.MatchString(name)
+	return matchUserName(name)
+}
+
+// UserValidator validates user ids, optionally relaxing the rules
+// applied by IsValidUser.
+type UserValidator struct {
+	// AllowEmail specifies that full email addresses, such as
+	// "bob.smith+ops@example.com", are valid user ids.
+	AllowEmail bool
+
+	// Existing holds the names of existing users. If it is not empty,
+	// ids that are visually confusable with, but not identical to,
+	// any of these names are invalid (see UserSkeleton).
+	Existing []string
+}
+
+// IsValidUser returns whether id is a valid user id under the
+// validator's rules.
+func (v UserValidator) IsValidUser(id string) bool {
+	if !IsValidUser(id) && !(v.AllowEmail && isValidEmail(id)) {
+		return false
+	}
+	return !isConfusableName(id, v.Existing)
+}
+
+// NewUserTag returns the tag for the user with the given id, which
+// must be valid under the validator's rules. An email address id, such
+// as "bob.smith+ops@example.com", is split at its last "@" into the
+// user's name and provider, as other ids are. It will panic if the id
+// is not valid.
+func (v UserValidator) NewUserTag(id string) UserTag {
+	if !v.IsValidUser(id) {
+		panic(fmt.Sprintf("invalid user tag %q", id))
+	}
+	if name, provider, ok := matchUser(id); ok {
+		return UserTag{name: name, provider: provider}
+	}
+	i := strings.LastIndex(id, "@")
+	return UserTag{name: id[:i], provider: id[i+1:]}
+}
+
+// ParseUserTag parses a user tag string, accepting any id that is
+// valid under the validator's rules. Tags of users whose ids are email
+// addresses can only be parsed this way, not by ParseTag or the
+// package level ParseUserTag, though the decoding methods of UserTag,
+// such as UnmarshalText and UnmarshalJSON, accept them.
+func (v UserValidator) ParseUserTag(tag string) (UserTag, error) {
+	kind, id, err := splitTag(tag)
+	if err != nil {
+		return UserTag{}, invalidTagError(tag, "")
+	}
+	if kind != UserTagKind || !v.IsValidUser(id) {
+		return UserTag{}, invalidTagError(tag, UserTagKind)
+	}
+	return v.NewUserTag(id), nil
+}
+
+// emailSpecials holds the non-alphanumeric characters permitted in
+// the dot-atom local part of an email address (RFC 5322, section 3.2.3).
+const emailSpecials = "!#$%&'*+-/=?^_`{|}~"
+
+// isValidEmail returns whether id is an email address of the form
+// local@domain, where local is a dot-atom and domain is a dotted
+// sequence of at least two host name labels (RFC 5321).
+func isValidEmail(id string) bool {
+	i := strings.LastIndex(id, "@")
+	if i <= 0 || len(id) > 254 {
+		return false
+	}
+	local, domain := id[:i], id[i+1:]
+	if len(local) > 64 || !isValidEmailLocal(local) {
+		return false
+	}
+	return isValidEmailDomain(domain)
+}
+
+func isValidEmailLocal(local string) bool {
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" {
+			return false
+		}
+		for _, c := range atom {
+			if !isASCIIAlnum(c) && !strings.ContainsRune(emailSpecials, c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isValidEmailDomain(domain string) bool {
+	if len(domain) > 253 {
+		return false
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 {
+			return false
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !isASCIIAlnum(c) && c != '-' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isASCIIAlnum(c rune) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
 }
 
 // UserTag represents a user that may be stored in the local database, or provided
@@ -38,6 +149,16 @@ type UserTag struct {
 func (t UserTag) Kind() string   { return UserTagKind }
 func (t UserTag) String() string { return UserTagKind + "-" + t.Id() }
 
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t UserTag) AppendString(dst []byte) []byte {
+	dst = append(append(dst, UserTagKind+KindSeparator...), t.name...)
+	if t.provider != "" {
+		dst = append(append(dst, '@'), t.provider...)
+	}
+	return dst
+}
+
 func (t UserTag) Id() string {
 	if t.provider == "" {
 		return t.name
@@ -64,11 +185,11 @@ func (t UserTag) Provider() string {
 
 // NewUserTag returns the tag for the user with the given name.
 func NewUserTag(userName string) UserTag {
-	parts := validName.FindStringSubmatch(userName)
-	if len(parts) != 3 {
+	name, provider, ok := matchUser(userName)
+	if !ok {
 		panic(fmt.Sprintf("invalid user tag %q", userName))
 	}
-	return UserTag{name: parts[1], provider: parts[2]}
+	return UserTag{name: name, provider: provider}
 }
 
 // NewLocalUserTag returns the tag for a local user with the given name.
@@ -4,7 +4,12 @@
 package names_test
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+
 	gc "gopkg.in/check.v1"
+	goyaml "gopkg.in/yaml.v2"
 
 	"github.com/juju/names"
 )
@@ -194,3 +199,103 @@ func (s *userSuite) TestNewLocalUserTag(c *gc.C) {
 	c.Assert(func() { names.NewLocalUserTag("") }, gc.PanicMatches, `invalid user name ""`)
 	c.Assert(func() { names.NewLocalUserTag("!@#") }, gc.PanicMatches, `invalid user name "!@#"`)
 }
+
+func (s *userSuite) TestUserValidatorAllowEmail(c *gc.C) {
+	strict := names.UserValidator{}
+	email := names.UserValidator{AllowEmail: true}
+	for i, t := range []struct {
+		string string
+		strict bool
+		email  bool
+	}{
+		{"bob", true, true},
+		{"bob@local", true, true},
+		{"bob@example.com", true, true},
+		{"bob.smith+ops@example.com", false, true},
+		{"bob_smith@mail.example.com", false, true},
+		{"o'brien@example.co.uk", false, true},
+		{"bob@example", true, true},
+		{"bob+1@example", false, false},
+		{"bob..smith+1@example.com", false, false},
+		{".bob@example.com", false, false},
+		{"bob.@example.com", false, false},
+		{"@example.com", false, false},
+		{"bob@", false, false},
+		{"bob+1@-example.com", false, false},
+		{"bob+1@example-.com", false, false},
+		{"bob+1@exa_mple.com", false, false},
+		{"bob+1@example..com", false, false},
+		{"bob smith@example.com", false, false},
+		{"bobé@example.com", false, false},
+		{"bob_smith", false, false},
+	} {
+		c.Logf("test %d: %s", i, t.string)
+		c.Check(strict.IsValidUser(t.string), gc.Equals, t.strict)
+		c.Check(email.IsValidUser(t.string), gc.Equals, t.email)
+	}
+}
+
+func (s *userSuite) TestUserValidatorTagsRoundTrip(c *gc.C) {
+	email := names.UserValidator{AllowEmail: true}
+	tag := email.NewUserTag("bob.smith+ops@example.com")
+
+	var text names.UserTag
+	c.Assert(text.UnmarshalText([]byte(tag.String())), gc.IsNil)
+	c.Check(text, gc.Equals, tag)
+
+	data, err := json.Marshal(tag)
+	c.Assert(err, gc.IsNil)
+	var fromJSON names.UserTag
+	c.Assert(json.Unmarshal(data, &fromJSON), gc.IsNil)
+	c.Check(fromJSON, gc.Equals, tag)
+
+	data, err = goyaml.Marshal(tag)
+	c.Assert(err, gc.IsNil)
+	var fromYAML names.UserTag
+	c.Assert(goyaml.Unmarshal(data, &fromYAML), gc.IsNil)
+	c.Check(fromYAML, gc.Equals, tag)
+
+	var buf bytes.Buffer
+	c.Assert(gob.NewEncoder(&buf).Encode(tag), gc.IsNil)
+	var fromGob names.UserTag
+	c.Assert(gob.NewDecoder(&buf).Decode(&fromGob), gc.IsNil)
+	c.Check(fromGob, gc.Equals, tag)
+
+	// Invalid ids are still rejected.
+	var invalid names.UserTag
+	c.Check(invalid.UnmarshalText([]byte("user-bob@")), gc.ErrorMatches, `"user-bob@" is not a valid user tag`)
+	c.Check(invalid.UnmarshalText([]byte("unit-mysql-0")), gc.ErrorMatches, `"unit-mysql-0" is not a valid user tag`)
+}
+
+func (s *userSuite) TestUserValidatorTags(c *gc.C) {
+	strict := names.UserValidator{}
+	email := names.UserValidator{AllowEmail: true}
+
+	tag := email.NewUserTag("bob.smith+ops@example.com")
+	c.Check(tag.Id(), gc.Equals, "bob.smith+ops@example.com")
+	c.Check(tag.Name(), gc.Equals, "bob.smith+ops")
+	c.Check(tag.Provider(), gc.Equals, "example.com")
+	c.Check(tag.String(), gc.Equals, "user-bob.smith+ops@example.com")
+	parsed, err := email.ParseUserTag(tag.String())
+	c.Check(err, gc.IsNil)
+	c.Check(parsed, gc.Equals, tag)
+
+	// Ids valid without the option make the same tags as NewUserTag.
+	c.Check(email.NewUserTag("bob"), gc.Equals, names.NewUserTag("bob"))
+	c.Check(email.NewUserTag("bob@example.com"), gc.Equals, names.NewUserTag("bob@example.com"))
+	parsed, err = strict.ParseUserTag("user-bob@local")
+	c.Check(err, gc.IsNil)
+	c.Check(parsed, gc.Equals, names.NewUserTag("bob@local"))
+
+	c.Check(func() { strict.NewUserTag("bob.smith+ops@example.com") }, gc.PanicMatches,
+		`invalid user tag "bob.smith\+ops@example.com"`)
+	_, err = strict.ParseUserTag("user-bob.smith+ops@example.com")
+	c.Check(err, gc.ErrorMatches, `"user-bob.smith\+ops@example.com" is not a valid user tag`)
+	_, err = email.ParseUserTag("service-bob.smith+ops@example.com")
+	c.Check(err, gc.ErrorMatches, `"service-bob.smith\+ops@example.com" is not a valid user tag`)
+	_, err = email.ParseUserTag("bob")
+	c.Check(err, gc.ErrorMatches, `"bob" is not a valid tag`)
+
+	existing := names.UserValidator{AllowEmail: true, Existing: []string{"admin"}}
+	c.Check(func() { existing.NewUserTag("Admin") }, gc.PanicMatches, `invalid user tag "Admin"`)
+}
@@ -0,0 +1,136 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+// Package names provides Juju entity tags as small opaque values.
+//
+// A Tag holds a kind and an id, both unexported, so a Tag can only be
+// made valid or zero: there are no per-kind structs with exported
+// fields to fill in by hand. Tags are comparable and may be used as map
+// keys. Kinds are the *TagKind constants of the original names package,
+// which is also used to validate tags; FromV1 and Tag.V1 convert
+// between the two representations.
+package names
+
+import (
+	"fmt"
+
+	v1 "github.com/juju/names"
+)
+
+// Tag identifies a Juju entity. The zero Tag identifies nothing.
+type Tag struct {
+	kind string
+	id   string
+}
+
+// New returns the tag of the given kind with the given id, or an error
+// if id is not a valid id for that kind of tag.
+func New(kind, id string) (Tag, error) {
+	t, err := v1.TagFromKindId(kind, id)
+	if err != nil {
+		return Tag{}, err
+	}
+	return Tag{kind: t.Kind(), id: t.Id()}, nil
+}
+
+// MustNew is like New but panics if the tag is not valid.
+func MustNew(kind, id string) Tag {
+	t, err := New(kind, id)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Parse parses the string representation of a tag, such as
+// "unit-mysql-0".
+func Parse(s string) (Tag, error) {
+	t, err := v1.ParseTag(s)
+	if err != nil {
+		return Tag{}, err
+	}
+	return Tag{kind: t.Kind(), id: t.Id()}, nil
+}
+
+// ParseKind is like Parse but also requires the tag to be of the given
+// kind.
+func ParseKind(s, kind string) (Tag, error) {
+	t, err := Parse(s)
+	if err != nil || t.kind != kind {
+		return Tag{}, fmt.Errorf("%q is not a valid %s tag", s, kind)
+	}
+	return t, nil
+}
+
+// FromV1 returns the tag identifying the same entity as t. A nil t, or
+// the zero value of a v1 tag type, yields the zero Tag. It returns an
+// error if t is not a valid tag, as may happen with implementations of
+// v1.Tag from outside the names package.
+func FromV1(t v1.Tag) (Tag, error) {
+	if t == nil || t.Id() == "" {
+		return Tag{}, nil
+	}
+	return New(t.Kind(), t.Id())
+}
+
+// V1 returns the original representation of t, or nil if t is zero.
+func (t Tag) V1() v1.Tag {
+	if t.IsZero() {
+		return nil
+	}
+	tag, err := v1.TagFromKindId(t.kind, t.id)
+	if err != nil {
+		// Tags are valid by construction.
+		panic(err)
+	}
+	return tag
+}
+
+// Kind returns the kind of the tag, or "" if t is zero.
+func (t Tag) Kind() string { return t.kind }
+
+// Id returns the id of the tag, or "" if t is zero.
+func (t Tag) Id() string { return t.id }
+
+// IsZero returns whether t is the zero Tag.
+func (t Tag) IsZero() bool { return t.kind == "" }
+
+// Is returns whether t is of the given kind.
+func (t Tag) Is(kind string) bool { return !t.IsZero() && t.kind == kind }
+
+// As returns t if it is of the given kind, or an error otherwise.
+func (t Tag) As(kind string) (Tag, error) {
+	if !t.Is(kind) {
+		return Tag{}, fmt.Errorf("%q is not a %s tag", t, kind)
+	}
+	return t, nil
+}
+
+// String returns the string representation of the tag, or "" if t is
+// zero.
+func (t Tag) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return string(v1.AppendKindId(nil, t.kind, t.id))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Tag) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text yields
+// the zero Tag.
+func (t *Tag) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*t = Tag{}
+		return nil
+	}
+	tag, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*t = tag
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"encoding/json"
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+
+	v1 "github.com/juju/names"
+	"github.com/juju/names/v2"
+)
+
+func Test(t *stdtesting.T) {
+	gc.TestingT(t)
+}
+
+type tagSuite struct{}
+
+var _ = gc.Suite(&tagSuite{})
+
+func (s *tagSuite) TestNew(c *gc.C) {
+	t, err := names.New(v1.UnitTagKind, "mysql/0")
+	c.Assert(err, gc.IsNil)
+	c.Check(t.Kind(), gc.Equals, v1.UnitTagKind)
+	c.Check(t.Id(), gc.Equals, "mysql/0")
+	c.Check(t.String(), gc.Equals, "unit-mysql-0")
+	c.Check(t.IsZero(), gc.Equals, false)
+
+	_, err = names.New(v1.UnitTagKind, "mysql")
+	c.Check(err, gc.ErrorMatches, `"mysql" is not a valid unit id`)
+	_, err = names.New("foo", "bar")
+	c.Check(err, gc.ErrorMatches, `"foo" is not a valid tag kind`)
+	c.Check(func() { names.MustNew("foo", "bar") }, gc.PanicMatches, `"foo" is not a valid tag kind`)
+}
+
+func (s *tagSuite) TestParse(c *gc.C) {
+	t, err := names.Parse("machine-0-lxc-1")
+	c.Assert(err, gc.IsNil)
+	c.Check(t, gc.Equals, names.MustNew(v1.MachineTagKind, "0/lxc/1"))
+
+	_, err = names.Parse("machine-0-lxc")
+	c.Check(err, gc.ErrorMatches, `"machine-0-lxc" is not a valid machine tag`)
+
+	t, err = names.ParseKind("user-bob", v1.UserTagKind)
+	c.Assert(err, gc.IsNil)
+	c.Check(t.Id(), gc.Equals, "bob")
+	_, err = names.ParseKind("user-bob", v1.UnitTagKind)
+	c.Check(err, gc.ErrorMatches, `"user-bob" is not a valid unit tag`)
+}
+
+func (s *tagSuite) TestZero(c *gc.C) {
+	var t names.Tag
+	c.Check(t.IsZero(), gc.Equals, true)
+	c.Check(t.String(), gc.Equals, "")
+	c.Check(t.V1(), gc.IsNil)
+	c.Check(t.Is(""), gc.Equals, false)
+	fromNil, err := names.FromV1(nil)
+	c.Check(err, gc.IsNil)
+	c.Check(fromNil, gc.Equals, t)
+	fromZero, err := names.FromV1(v1.UnitTag{})
+	c.Check(err, gc.IsNil)
+	c.Check(fromZero, gc.Equals, t)
+	c.Check(fromZero.V1(), gc.IsNil)
+}
+
+// badTag is a v1.Tag implementation holding an invalid id.
+type badTag struct{}
+
+func (badTag) Kind() string   { return v1.UnitTagKind }
+func (badTag) Id() string     { return "mysql" }
+func (badTag) String() string { return "unit-mysql" }
+
+func (s *tagSuite) TestFromV1Invalid(c *gc.C) {
+	t, err := names.FromV1(badTag{})
+	c.Check(err, gc.ErrorMatches, `"mysql" is not a valid unit id`)
+	c.Check(t.IsZero(), gc.Equals, true)
+}
+
+func (s *tagSuite) TestKindCheck(c *gc.C) {
+	t := names.MustNew(v1.ServiceTagKind, "mysql")
+	c.Check(t.Is(v1.ServiceTagKind), gc.Equals, true)
+	c.Check(t.Is(v1.UnitTagKind), gc.Equals, false)
+	u, err := t.As(v1.ServiceTagKind)
+	c.Assert(err, gc.IsNil)
+	c.Check(u, gc.Equals, t)
+	_, err = t.As(v1.UnitTagKind)
+	c.Check(err, gc.ErrorMatches, `"service-mysql" is not a unit tag`)
+}
+
+func (s *tagSuite) TestV1(c *gc.C) {
+	for _, tag := range []v1.Tag{
+		v1.NewUnitTag("wordpress-site/12"),
+		v1.NewMachineTag("0/kvm/1"),
+		v1.NewUserTag("bob@remote"),
+		v1.NewRelationTag("wordpress:db mysql:server"),
+		v1.NewActionTag("mysql/0" + v1.ActionMarker + "3"),
+	} {
+		t, err := names.FromV1(tag)
+		c.Assert(err, gc.IsNil)
+		c.Check(t.String(), gc.Equals, tag.String())
+		c.Check(t.V1(), gc.Equals, tag)
+	}
+}
+
+func (s *tagSuite) TestMapKeyAndJSON(c *gc.C) {
+	counts := map[names.Tag]int{}
+	counts[names.MustNew(v1.UnitTagKind, "mysql/0")]++
+	fromV1, err := names.FromV1(v1.NewUnitTag("mysql/0"))
+	c.Assert(err, gc.IsNil)
+	counts[fromV1]++
+	c.Check(counts, gc.HasLen, 1)
+
+	data, err := json.Marshal(counts)
+	c.Assert(err, gc.IsNil)
+	c.Check(string(data), gc.Equals, `{"unit-mysql-0":2}`)
+	var decoded map[names.Tag]int
+	c.Assert(json.Unmarshal(data, &decoded), gc.IsNil)
+	c.Check(decoded, gc.DeepEquals, counts)
+
+	var t names.Tag
+	c.Check(json.Unmarshal([]byte(`"unit-mysql"`), &t), gc.ErrorMatches, `"unit-mysql" is not a valid unit tag`)
+	c.Assert(json.Unmarshal([]byte(`""`), &t), gc.IsNil)
+	c.Check(t.IsZero(), gc.Equals, true)
+}
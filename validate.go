@@ -0,0 +1,133 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// ValidationReport holds the result of validating a batch of tags
+// with ValidateAll.
+type ValidationReport struct {
+	// Checked holds the number of tags that were validated. It is
+	// less than the number given when validation stopped early.
+	Checked int
+
+	// Failures holds the tags that failed validation, in input order.
+	Failures []ValidationFailure
+}
+
+// OK returns whether every tag checked was valid.
+func (r ValidationReport) OK() bool {
+	return len(r.Failures) == 0
+}
+
+// ValidationFailure describes a tag that failed validation.
+type ValidationFailure struct {
+	// Index holds the position of the tag in the input.
+	Index int
+
+	// Tag holds the tag as given.
+	Tag string
+
+	// Err holds the reason the tag is invalid.
+	Err error
+}
+
+// ValidateOption configures ValidateAll.
+type ValidateOption func(*validateConfig)
+
+type validateConfig struct {
+	workers  int
+	failFast bool
+}
+
+// ValidateWorkers sets the number of tags validated concurrently. By
+// default, one worker is used per CPU.
+func ValidateWorkers(n int) ValidateOption {
+	return func(c *validateConfig) {
+		c.workers = n
+	}
+}
+
+// ValidateFailFast stops validation at the first invalid tag found.
+// As tags are validated concurrently, tags after it in the input may
+// have been checked, and a few other failures may be reported.
+func ValidateFailFast() ValidateOption {
+	return func(c *validateConfig) {
+		c.failFast = true
+	}
+}
+
+// validateChunk holds the number of tags a worker validates at a
+// time.
+const validateChunk = 1024
+
+// ValidateAll parses each of the given tag strings concurrently,
+// reporting those that are not valid.
+func ValidateAll(tags []string, opts ...ValidateOption) ValidationReport {
+	config := validateConfig{workers: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.workers < 1 {
+		config.workers = 1
+	}
+
+	var (
+		next    int64 // start of the next chunk to validate
+		checked int64
+		failed  int32
+		mu      sync.Mutex
+		report  ValidationReport
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < config.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var failures []ValidationFailure
+			for {
+				if config.failFast && atomic.LoadInt32(&failed) != 0 {
+					break
+				}
+				start := int(atomic.AddInt64(&next, validateChunk)) - validateChunk
+				if start >= len(tags) {
+					break
+				}
+				end := start + validateChunk
+				if end > len(tags) {
+					end = len(tags)
+				}
+				for j := start; j < end; j++ {
+					if _, err := ParseTag(tags[j]); err != nil {
+						failures = append(failures, ValidationFailure{Index: j, Tag: tags[j], Err: err})
+						if config.failFast {
+							atomic.StoreInt32(&failed, 1)
+							end = j + 1
+							break
+						}
+					}
+				}
+				atomic.AddInt64(&checked, int64(end-start))
+			}
+			mu.Lock()
+			report.Failures = append(report.Failures, failures...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	report.Checked = int(checked)
+	sort.Sort(byIndex(report.Failures))
+	return report
+}
+
+type byIndex []ValidationFailure
+
+func (f byIndex) Len() int           { return len(f) }
+func (f byIndex) Less(i, j int) bool { return f[i].Index < f[j].Index }
+func (f byIndex) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
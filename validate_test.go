@@ -0,0 +1,60 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type validateSuite struct{}
+
+var _ = gc.Suite(&validateSuite{})
+
+func validateInput(n int, invalid ...int) []string {
+	tags := make([]string, n)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("unit-mysql-%d", i)
+	}
+	for _, i := range invalid {
+		tags[i] = fmt.Sprintf("unit-mysql-0%d", i)
+	}
+	return tags
+}
+
+func (s *validateSuite) TestValidateAll(c *gc.C) {
+	report := names.ValidateAll(validateInput(5000))
+	c.Check(report.OK(), gc.Equals, true)
+	c.Check(report.Checked, gc.Equals, 5000)
+
+	report = names.ValidateAll(validateInput(5000, 4321, 7, 2048), names.ValidateWorkers(3))
+	c.Check(report.OK(), gc.Equals, false)
+	c.Check(report.Checked, gc.Equals, 5000)
+	c.Assert(report.Failures, gc.HasLen, 3)
+	for i, index := range []int{7, 2048, 4321} {
+		failure := report.Failures[i]
+		c.Check(failure.Index, gc.Equals, index)
+		c.Check(failure.Tag, gc.Equals, fmt.Sprintf("unit-mysql-0%d", index))
+		c.Check(failure.Err, gc.ErrorMatches, `"unit-mysql-0\d+" is not a valid unit tag`)
+	}
+
+	report = names.ValidateAll(nil)
+	c.Check(report.OK(), gc.Equals, true)
+	c.Check(report.Checked, gc.Equals, 0)
+}
+
+func (s *validateSuite) TestValidateAllFailFast(c *gc.C) {
+	report := names.ValidateAll(validateInput(100000, 10), names.ValidateWorkers(1), names.ValidateFailFast())
+	c.Check(report.Checked, gc.Equals, 11)
+	c.Assert(report.Failures, gc.HasLen, 1)
+	c.Check(report.Failures[0].Index, gc.Equals, 10)
+
+	report = names.ValidateAll(validateInput(100000, 10, 50000), names.ValidateFailFast())
+	c.Check(report.OK(), gc.Equals, false)
+	c.Check(report.Failures[0].Index, gc.Equals, 10)
+	c.Check(report.Checked < 100000, gc.Equals, true)
+}
@@ -0,0 +1,120 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+	"strings"
+)
+
+const VolumeTagKind = "volume"
+
+// VolumeSnippet matches a volume id: a number, optionally scoped to a
+// machine or unit, such as "3", "0/3" or "mysql/0/3".
+const VolumeSnippet = "(?:(?:" + MachineSnippet + "|" + ServiceSnippet + "/" + NumberSnippet + ")/)?" + NumberSnippet
+
+// IsValidVolume returns whether id is a valid volume id.
+func IsValidVolume(id string) bool {
+	return isValidStorageId(id)
+}
+
+// isValidStorageId returns whether id is a valid volume or filesystem
+// id. The machine or unit to which a scoped id belongs must itself be
+// valid, so that the host of a valid id can always be parsed.
+func isValidStorageId(id string) bool {
+	if !matchVolume(id) {
+		return false
+	}
+	_, scoped := volumeHost(id)
+	return scoped || !strings.Contains(id, "/")
+}
+
+type VolumeTag struct {
+	id string
+}
+
+func (t VolumeTag) String() string { return t.Kind() + "-" + t.id }
+func (t VolumeTag) Kind() string   { return VolumeTagKind }
+func (t VolumeTag) Id() string     { return volumeTagSuffixToId(t.id) }
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t VolumeTag) AppendString(dst []byte) []byte {
+	return append(append(dst, VolumeTagKind+KindSeparator...), t.id...)
+}
+
+// Host returns the tag of the machine or unit to which the volume is
+// scoped, and whether it is scoped to one.
+func (t VolumeTag) Host() (Tag, bool) {
+	return volumeHost(t.Id())
+}
+
+// IsHostScoped returns whether the volume is scoped to a machine or
+// unit.
+func (t VolumeTag) IsHostScoped() bool {
+	_, ok := t.Host()
+	return ok
+}
+
+// NewVolumeTag returns the tag for the volume with the given id.
+// It will panic if the given volume id is not valid.
+func NewVolumeTag(id string) VolumeTag {
+	if !IsValidVolume(id) {
+		panic(fmt.Sprintf("%q is not a valid volume id", id))
+	}
+	return VolumeTag{id: strings.Replace(id, "/", KindSeparator, -1)}
+}
+
+// ParseVolumeTag parses a volume tag string.
+func ParseVolumeTag(volumeTag string) (VolumeTag, error) {
+	tag, err := ParseTag(volumeTag)
+	if err != nil {
+		return VolumeTag{}, err
+	}
+	vt, ok := tag.(VolumeTag)
+	if !ok {
+		return VolumeTag{}, invalidTagError(volumeTag, VolumeTagKind)
+	}
+	return vt, nil
+}
+
+// volumeHost returns the tag of the machine or unit to which the
+// volume with the given id is scoped, and whether it is scoped to one.
+func volumeHost(id string) (Tag, bool) {
+	i := strings.LastIndex(id, "/")
+	if i < 0 {
+		return nil, false
+	}
+	return hostTag(id[:i])
+}
+
+// hostTag returns the tag of the machine or unit with the given id, to
+// which storage may be scoped or attached, and whether id is valid.
+func hostTag(id string) (Tag, bool) {
+	switch {
+	case IsValidMachine(id):
+		return NewMachineTag(id), true
+	case IsValidUnit(id):
+		return NewUnitTag(id), true
+	}
+	return nil, false
+}
+
+// hostTagSuffixToId converts the tag suffix of a machine or unit to its
+// id. Machine ids start with a digit and unit names with a letter, so
+// the kind of the host can be told from its suffix.
+func hostTagSuffixToId(s string) string {
+	if s != "" && s[0] >= '0' && s[0] <= '9' {
+		return machineTagSuffixToId(s)
+	}
+	return unitTagSuffixToId(s)
+}
+
+func volumeTagSuffixToId(s string) string {
+	i := strings.LastIndex(s, "-")
+	if i < 0 {
+		return s
+	}
+	return hostTagSuffixToId(s[:i]) + "/" + s[i+1:]
+}
@@ -0,0 +1,107 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	"fmt"
+	"regexp"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type volumeSuite struct{}
+
+var _ = gc.Suite(&volumeSuite{})
+
+var volumeIdTests = []struct {
+	id    string
+	tag   string
+	valid bool
+	host  names.Tag
+}{
+	{id: "0", tag: "volume-0", valid: true},
+	{id: "42", tag: "volume-42", valid: true},
+	{id: "0/3", tag: "volume-0-3", valid: true, host: names.NewMachineTag("0")},
+	{id: "0/lxc/1/3", tag: "volume-0-lxc-1-3", valid: true, host: names.NewMachineTag("0/lxc/1")},
+	{id: "mysql/0/3", tag: "volume-mysql-0-3", valid: true, host: names.NewUnitTag("mysql/0")},
+	{id: "my-sql/10/3", tag: "volume-my-sql-10-3", valid: true, host: names.NewUnitTag("my-sql/10")},
+	{id: ""},
+	{id: "03"},
+	{id: "-1"},
+	{id: "a"},
+	{id: "mysql/3"},
+	{id: "0/lxc/3"},
+	{id: "0/lxc/1/kvm/2/lxc/3/4"},
+	{id: "0/"},
+	{id: "/3"},
+	{id: "0-3"},
+	{id: "0/03"},
+}
+
+func (s *volumeSuite) TestVolumeTag(c *gc.C) {
+	for i, test := range volumeIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidVolume(test.id), gc.Equals, test.valid)
+		if !test.valid {
+			expectErr := fmt.Sprintf("%q is not a valid volume id", test.id)
+			testVolumeTag := func() { names.NewVolumeTag(test.id) }
+			c.Check(testVolumeTag, gc.PanicMatches, regexp.QuoteMeta(expectErr))
+			continue
+		}
+		tag := names.NewVolumeTag(test.id)
+		c.Check(tag.String(), gc.Equals, test.tag)
+		c.Check(tag.Id(), gc.Equals, test.id)
+		c.Check(tag.Kind(), gc.Equals, names.VolumeTagKind)
+		host, ok := tag.Host()
+		c.Check(ok, gc.Equals, test.host != nil)
+		c.Check(host, gc.Equals, test.host)
+		c.Check(tag.IsHostScoped(), gc.Equals, test.host != nil)
+
+		parsed, err := names.ParseVolumeTag(test.tag)
+		c.Check(err, gc.IsNil)
+		c.Check(parsed, gc.Equals, tag)
+	}
+}
+
+var parseVolumeTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "volume-0",
+	expected: names.NewVolumeTag("0"),
+}, {
+	tag:      "volume-mysql-0-1",
+	expected: names.NewVolumeTag("mysql/0/1"),
+}, {
+	tag: "volume-mysql-0",
+	err: names.InvalidTagError("volume-mysql-0", names.VolumeTagKind),
+}, {
+	tag: "volume-0-lxc-1",
+	err: names.InvalidTagError("volume-0-lxc-1", names.VolumeTagKind),
+}, {
+	tag: "volume",
+	err: names.InvalidTagError("volume", ""),
+}, {
+	tag: "machine-0",
+	err: names.InvalidTagError("machine-0", names.VolumeTagKind),
+}}
+
+func (s *volumeSuite) TestParseVolumeTag(c *gc.C) {
+	for i, t := range parseVolumeTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseVolumeTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}
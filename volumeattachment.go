@@ -0,0 +1,72 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names
+
+import (
+	"fmt"
+)
+
+const VolumeAttachmentTagKind = "volumeattachment"
+
+// IsValidVolumeAttachment returns whether id is a valid volume
+// attachment id: the id of a machine and the id of a volume attached to
+// it, separated by AttachmentSeparator, such as "0:0/3".
+func IsValidVolumeAttachment(id string) bool {
+	machine, volume, ok := splitAttachmentId(id)
+	return ok && IsValidMachine(machine) && IsValidVolume(volume)
+}
+
+// VolumeAttachmentTag represents the attachment of a volume to a
+// machine.
+type VolumeAttachmentTag struct {
+	machine MachineTag
+	volume  VolumeTag
+}
+
+func (t VolumeAttachmentTag) String() string { return t.Kind() + "-" + t.suffix() }
+func (t VolumeAttachmentTag) Kind() string   { return VolumeAttachmentTagKind }
+func (t VolumeAttachmentTag) Id() string {
+	return t.machine.Id() + AttachmentSeparator + t.volume.Id()
+}
+
+func (t VolumeAttachmentTag) suffix() string {
+	return t.machine.id + AttachmentSeparator + t.volume.id
+}
+
+// AppendString appends the string representation of the tag to dst and
+// returns the extended buffer.
+func (t VolumeAttachmentTag) AppendString(dst []byte) []byte {
+	dst = append(dst, VolumeAttachmentTagKind+KindSeparator...)
+	return append(append(append(dst, t.machine.id...), AttachmentSeparator...), t.volume.id...)
+}
+
+// Machine returns the tag of the machine to which the volume is
+// attached.
+func (t VolumeAttachmentTag) Machine() MachineTag { return t.machine }
+
+// Volume returns the tag of the attached volume.
+func (t VolumeAttachmentTag) Volume() VolumeTag { return t.volume }
+
+// NewVolumeAttachmentTag returns the tag for the attachment of the
+// given volume to the given machine. It will panic if either tag is the
+// zero value.
+func NewVolumeAttachmentTag(machine MachineTag, volume VolumeTag) VolumeAttachmentTag {
+	if machine == (MachineTag{}) || volume == (VolumeTag{}) {
+		panic(fmt.Sprintf("cannot attach volume %q to machine %q", volume.Id(), machine.Id()))
+	}
+	return VolumeAttachmentTag{machine: machine, volume: volume}
+}
+
+// ParseVolumeAttachmentTag parses a volume attachment tag string.
+func ParseVolumeAttachmentTag(volumeAttachmentTag string) (VolumeAttachmentTag, error) {
+	tag, err := ParseTag(volumeAttachmentTag)
+	if err != nil {
+		return VolumeAttachmentTag{}, err
+	}
+	vt, ok := tag.(VolumeAttachmentTag)
+	if !ok {
+		return VolumeAttachmentTag{}, invalidTagError(volumeAttachmentTag, VolumeAttachmentTagKind)
+	}
+	return vt, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2014 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package names_test
+
+import (
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/names"
+)
+
+type volumeAttachmentSuite struct{}
+
+var _ = gc.Suite(&volumeAttachmentSuite{})
+
+func (s *volumeAttachmentSuite) TestVolumeAttachmentTag(c *gc.C) {
+	machine := names.NewMachineTag("0/lxc/1")
+	volume := names.NewVolumeTag("0/lxc/1/3")
+	tag := names.NewVolumeAttachmentTag(machine, volume)
+	c.Check(tag.String(), gc.Equals, "volumeattachment-0-lxc-1:0-lxc-1-3")
+	c.Check(tag.Kind(), gc.Equals, names.VolumeAttachmentTagKind)
+	c.Check(tag.Id(), gc.Equals, "0/lxc/1:0/lxc/1/3")
+	c.Check(tag.Machine(), gc.Equals, machine)
+	c.Check(tag.Volume(), gc.Equals, volume)
+}
+
+func (s *volumeAttachmentSuite) TestNewVolumeAttachmentTagZero(c *gc.C) {
+	c.Check(func() {
+		names.NewVolumeAttachmentTag(names.MachineTag{}, names.NewVolumeTag("3"))
+	}, gc.PanicMatches, `cannot attach volume "3" to machine ""`)
+	c.Check(func() {
+		names.NewVolumeAttachmentTag(names.NewMachineTag("0"), names.VolumeTag{})
+	}, gc.PanicMatches, `cannot attach volume "" to machine "0"`)
+}
+
+var volumeAttachmentIdTests = []struct {
+	id    string
+	valid bool
+}{
+	{id: "0:3", valid: true},
+	{id: "0:0/3", valid: true},
+	{id: "0/lxc/1:mysql/0/3", valid: true},
+	{id: "0"},
+	{id: "0:"},
+	{id: ":3"},
+	{id: "mysql/0:3"},
+	{id: "0:03"},
+	{id: "0:3:4"},
+	{id: "0-3"},
+}
+
+func (s *volumeAttachmentSuite) TestIsValidVolumeAttachment(c *gc.C) {
+	for i, test := range volumeAttachmentIdTests {
+		c.Logf("test %d: %q", i, test.id)
+		c.Check(names.IsValidVolumeAttachment(test.id), gc.Equals, test.valid)
+	}
+}
+
+var parseVolumeAttachmentTagTests = []struct {
+	tag      string
+	expected names.Tag
+	err      error
+}{{
+	tag: "",
+	err: names.InvalidTagError("", ""),
+}, {
+	tag:      "volumeattachment-0:0-3",
+	expected: names.NewVolumeAttachmentTag(names.NewMachineTag("0"), names.NewVolumeTag("0/3")),
+}, {
+	tag: "volumeattachment-0",
+	err: names.InvalidTagError("volumeattachment-0", names.VolumeAttachmentTagKind),
+}, {
+	tag: "volumeattachment",
+	err: names.InvalidTagError("volumeattachment", ""),
+}, {
+	tag: "volume-0-3",
+	err: names.InvalidTagError("volume-0-3", names.VolumeAttachmentTagKind),
+}}
+
+func (s *volumeAttachmentSuite) TestParseVolumeAttachmentTag(c *gc.C) {
+	for i, t := range parseVolumeAttachmentTagTests {
+		c.Logf("test %d: %s", i, t.tag)
+		got, err := names.ParseVolumeAttachmentTag(t.tag)
+		if err != nil || t.err != nil {
+			c.Check(err, gc.DeepEquals, t.err)
+			continue
+		}
+		c.Check(got, gc.FitsTypeOf, t.expected)
+		c.Check(got, gc.Equals, t.expected)
+	}
+}